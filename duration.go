@@ -2,7 +2,7 @@ package influxdb
 
 import (
 	"encoding/json"
-	"errors"
+	"fmt"
 	"time"
 )
 
@@ -30,10 +30,17 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 		var err error
 		d.Duration, err = time.ParseDuration(value)
 		if err != nil {
-			return err
+			return &Error{
+				Code: EInvalid,
+				Msg:  fmt.Sprintf("invalid duration %q", value),
+				Err:  err,
+			}
 		}
 		return nil
 	default:
-		return errors.New("invalid duration")
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("invalid duration %s", b),
+		}
 	}
 }
@@ -708,6 +708,8 @@ func (n *notificationEndpointEncoder) MarshalJSON() ([]byte, error) {
 
 	// this makes me queezy and altogether sad
 	fieldMap := map[string]string{
+		"-client-cert": "clientCert",
+		"-client-key":  "clientKey",
 		"-password":    "password",
 		"-routing-key": "routingKey",
 		"-token":       "token",
@@ -48,6 +48,14 @@ func newEndpointStore() *IndexStore {
 	}
 }
 
+// normalizeNotificationEndpoint canonicalizes the endpoint before it is validated
+// and stored, for the endpoint types that support it.
+func normalizeNotificationEndpoint(edp influxdb.NotificationEndpoint) {
+	if n, ok := edp.(interface{ Normalize() }); ok {
+		n.Normalize()
+	}
+}
+
 // CreateNotificationEndpoint creates a new notification endpoint and sets b.ID with the new identifier.
 func (s *Service) CreateNotificationEndpoint(ctx context.Context, edp influxdb.NotificationEndpoint, userID influxdb.ID) error {
 	return s.kv.Update(ctx, func(tx Tx) error {
@@ -56,29 +64,29 @@ func (s *Service) CreateNotificationEndpoint(ctx context.Context, edp influxdb.N
 }
 
 func (s *Service) createNotificationEndpoint(ctx context.Context, tx Tx, edp influxdb.NotificationEndpoint, userID influxdb.ID) error {
-	// TODO(jsteenb2): why is org id check not necesssary if orgID isn't valid... feels odd
-	if edp.GetOrgID().Valid() {
-		span, ctx := tracing.StartSpanFromContext(ctx)
-		// TODO(jsteenb2): this defer doesn't get called until the end of entire function,
-		//  need to rip this out as is
-		defer span.Finish()
-
-		if _, err := s.findOrganizationByID(ctx, tx, edp.GetOrgID()); err != nil {
-			return err
-		}
-	}
-
 	id := s.IDGenerator.ID()
 	edp.SetID(id)
 	now := s.TimeGenerator.Now()
 	edp.SetCreatedAt(now)
 	edp.SetUpdatedAt(now)
 	edp.BackfillSecretKeys()
+	normalizeNotificationEndpoint(edp)
 
+	// Valid rejects an invalid org id, so the org is always looked up after it.
+	// Endpoints without an org were never stored, the org/name index needs one.
 	if err := edp.Valid(); err != nil {
 		return err
 	}
 
+	span, ctx := tracing.StartSpanFromContext(ctx)
+	// TODO(jsteenb2): this defer doesn't get called until the end of entire function,
+	//  need to rip this out as is
+	defer span.Finish()
+
+	if _, err := s.findOrganizationByID(ctx, tx, edp.GetOrgID()); err != nil {
+		return err
+	}
+
 	ent := Entity{
 		PK:        EncID(edp.GetID()),
 		UniqueKey: Encode(EncID(edp.GetOrgID()), EncString(edp.GetName())),
@@ -117,6 +125,7 @@ func (s *Service) updateNotificationEndpoint(ctx context.Context, tx Tx, id infl
 	// ID and OrganizationID can not be updated
 	edp.SetCreatedAt(current.GetCRUDLog().CreatedAt)
 	edp.SetUpdatedAt(s.TimeGenerator.Now())
+	normalizeNotificationEndpoint(edp)
 
 	if err := edp.Valid(); err != nil {
 		return nil, err
@@ -190,6 +199,7 @@ func (s *Service) patchNotificationEndpoint(ctx context.Context, tx Tx, id influ
 func (s *Service) PutNotificationEndpoint(ctx context.Context, edp influxdb.NotificationEndpoint) error {
 	// TODO(jsteenb2): all the stuffs before the update should be moved up into the
 	//  service layer as well as all the id/time setting items
+	normalizeNotificationEndpoint(edp)
 	if err := edp.Valid(); err != nil {
 		return err
 	}
@@ -7,6 +7,8 @@ import (
 	"github.com/influxdata/influxdb/v2"
 	"github.com/influxdata/influxdb/v2/endpoints"
 	"github.com/influxdata/influxdb/v2/kv"
+	"github.com/influxdata/influxdb/v2/mock"
+	"github.com/influxdata/influxdb/v2/notification/endpoint"
 	influxdbtesting "github.com/influxdata/influxdb/v2/testing"
 	"go.uber.org/zap/zaptest"
 )
@@ -15,6 +17,60 @@ func TestNotificationEndpointService(t *testing.T) {
 	influxdbtesting.NotificationEndpointService(initBoltNotificationEndpointService, t)
 }
 
+func TestNotificationEndpointService_CreateWithoutOrg(t *testing.T) {
+	store, closeBolt, err := NewTestBoltStore(t)
+	if err != nil {
+		t.Fatalf("failed to create new kv store: %v", err)
+	}
+	defer closeBolt()
+
+	svc := kv.NewService(zaptest.NewLogger(t), store)
+	svc.IDGenerator = mock.NewIDGenerator("020f755c3c082000", t)
+	svc.TimeGenerator = influxdb.RealTimeGenerator{}
+
+	tests := []struct {
+		name  string
+		orgID *influxdb.ID
+		err   error
+	}{
+		{
+			name: "missing org id",
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint OrgID is invalid",
+			},
+		},
+		{
+			name:  "nonexistent org",
+			orgID: influxdbtesting.IDPtr(influxdbtesting.MustIDBase16("020f755c3c082001")),
+			err: &influxdb.Error{
+				Code: influxdb.ENotFound,
+				Msg:  "organization not found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			edp := &endpoint.Slack{
+				Base: endpoint.Base{
+					Name:   "slack",
+					OrgID:  tt.orgID,
+					Status: influxdb.Active,
+				},
+				URL: "https://hooks.slack.com/services/x/y/z",
+			}
+			err := svc.CreateNotificationEndpoint(ctx, edp, influxdbtesting.MustIDBase16("020f755c3c082002"))
+			influxdbtesting.ErrorsEqual(t, err, tt.err)
+
+			_, err = svc.FindNotificationEndpointByID(ctx, influxdbtesting.MustIDBase16("020f755c3c082000"))
+			if influxdb.ErrorCode(err) != influxdb.ENotFound {
+				t.Errorf("expected endpoint not to be stored, got err %v", err)
+			}
+		})
+	}
+}
+
 func initBoltNotificationEndpointService(f influxdbtesting.NotificationEndpointFields, t *testing.T) (influxdb.NotificationEndpointService, influxdb.SecretService, func()) {
 	store, closeBolt, err := NewTestBoltStore(t)
 	if err != nil {
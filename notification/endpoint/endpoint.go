@@ -3,6 +3,9 @@ package endpoint
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/influxdata/influxdb/v2"
 )
@@ -41,6 +44,9 @@ func UnmarshalJSON(b []byte) (influxdb.NotificationEndpoint, error) {
 	converted := convertedFunc()
 
 	if err := json.Unmarshal(b, converted); err != nil {
+		if influxdb.ErrorCode(err) == influxdb.EInvalid {
+			return nil, err
+		}
 		return nil, &influxdb.Error{
 			Code: influxdb.EInternal,
 			Err:  err,
@@ -49,6 +55,18 @@ func UnmarshalJSON(b []byte) (influxdb.NotificationEndpoint, error) {
 	return converted, nil
 }
 
+// ValidateAll validates each of the notification endpoints, it returns the errors
+// of the invalid ones keyed by their index in es.
+func ValidateAll(es []influxdb.NotificationEndpoint) map[int]error {
+	errs := make(map[int]error)
+	for i, e := range es {
+		if err := e.Valid(); err != nil {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
 // Base is the embed struct of every notification endpoint.
 type Base struct {
 	ID          *influxdb.ID    `json:"id,omitempty"`
@@ -56,6 +74,21 @@ type Base struct {
 	Description string          `json:"description,omitempty"`
 	OrgID       *influxdb.ID    `json:"orgID,omitempty"`
 	Status      influxdb.Status `json:"status"`
+	// Suspended is set when the endpoint is disabled by the system,
+	// e.g. due to repeated failures. It is independent of Status.
+	Suspended       bool   `json:"suspended,omitempty"`
+	SuspendedReason string `json:"suspendedReason,omitempty"`
+	// RateLimit is the maximum number of messages per minute, 0 is unlimited.
+	RateLimit int `json:"rateLimit,omitempty"`
+	// DefaultTitle is the message title for rules that don't specify one.
+	// It is validated and stored, but the notification rules don't use it yet.
+	DefaultTitle string `json:"defaultTitle,omitempty"`
+	// Footer is the text to append to the messages of the endpoint.
+	// It is validated and stored, but the notification rules don't apply it yet.
+	Footer string `json:"footer,omitempty"`
+	// SeverityFilter lists the severities the endpoint accepts, empty accepts all.
+	// The notification rules don't enforce it, callers check it with AcceptsSeverity.
+	SeverityFilter []string `json:"severityFilter,omitempty"`
 	influxdb.CRUDLog
 }
 
@@ -66,10 +99,45 @@ func (b Base) idStr() string {
 	return b.ID.String()
 }
 
+// logString renders the endpoint for logging, it never includes secret values.
+func (b Base) logString(typ string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", typ, b.Name, b.idStr(), b.Status)
+}
+
 func (b Base) validID() bool {
 	return b.ID != nil && b.ID.Valid()
 }
 
+func (b Base) validOrgID() bool {
+	return b.OrgID != nil && b.OrgID.Valid()
+}
+
+const (
+	maxDefaultTitleLength = 256
+	maxFooterLength       = 200
+)
+
+var goodSeverity = map[string]bool{
+	"crit": true,
+	"warn": true,
+	"info": true,
+	"ok":   true,
+}
+
+// AcceptsSeverity returns true if the endpoint's severity filter accepts the severity.
+// The generated notification rule tasks don't call it, it is up to the callers.
+func (b Base) AcceptsSeverity(severity string) bool {
+	if len(b.SeverityFilter) == 0 {
+		return true
+	}
+	for _, sev := range b.SeverityFilter {
+		if sev == severity {
+			return true
+		}
+	}
+	return false
+}
+
 func (b Base) valid() error {
 	if !b.validID() {
 		return &influxdb.Error{
@@ -83,12 +151,44 @@ func (b Base) valid() error {
 			Msg:  "Notification Endpoint Name can't be empty",
 		}
 	}
+	if !b.validOrgID() {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "Notification Endpoint OrgID is invalid",
+		}
+	}
 	if b.Status != influxdb.Active && b.Status != influxdb.Inactive {
 		return &influxdb.Error{
 			Code: influxdb.EInvalid,
 			Msg:  "invalid status",
 		}
 	}
+	if b.RateLimit < 0 {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "Notification Endpoint RateLimit can't be negative",
+		}
+	}
+	if utf8.RuneCountInString(b.DefaultTitle) > maxDefaultTitleLength {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("Notification Endpoint DefaultTitle can't be longer than %d characters", maxDefaultTitleLength),
+		}
+	}
+	if utf8.RuneCountInString(b.Footer) > maxFooterLength {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("Notification Endpoint Footer can't be longer than %d characters", maxFooterLength),
+		}
+	}
+	for _, sev := range b.SeverityFilter {
+		if !goodSeverity[sev] {
+			return &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  fmt.Sprintf("Notification Endpoint SeverityFilter has unknown severity %q", sev),
+			}
+		}
+	}
 	return nil
 }
 
@@ -150,6 +250,24 @@ func (b *Base) SetStatus(status influxdb.Status) {
 	b.Status = status
 }
 
+// normalizeURL lowercases the scheme and host, strips the default port
+// and removes the trailing slash of the path. Path and query are otherwise kept intact.
+// Unparsable urls are returned as is, they are reported by Valid.
+func normalizeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+	return u.String()
+}
+
 func getID(id *influxdb.ID) influxdb.ID {
 	if id == nil {
 		return 0
@@ -1,8 +1,17 @@
 package endpoint_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +36,8 @@ var goodBase = endpoint.Base{
 }
 
 func TestValidEndpoint(t *testing.T) {
+	cert1, key1 := mustPEMKeyPair()
+	_, key2 := mustPEMKeyPair()
 	cases := []struct {
 		name string
 		src  influxdb.NotificationEndpoint
@@ -40,6 +51,54 @@ func TestValidEndpoint(t *testing.T) {
 				Msg:  "Notification Endpoint ID is invalid",
 			},
 		},
+		{
+			name: "missing org id",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					Status: influxdb.Active,
+				},
+				URL: "https://hooks.slack.com/services/x/y/z",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint OrgID is invalid",
+			},
+		},
+		{
+			name: "zero org id",
+			src: &endpoint.PagerDuty{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  new(influxdb.ID),
+					Status: influxdb.Active,
+				},
+				RoutingKey: influxdb.SecretField{Key: id1 + "-routing-key"},
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint OrgID is invalid",
+			},
+		},
+		{
+			name: "http missing org id",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					Status: influxdb.Active,
+				},
+				URL:        "localhost",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint OrgID is invalid",
+			},
+		},
 		{
 			name: "invalid status",
 			src: &endpoint.PagerDuty{
@@ -70,6 +129,111 @@ func TestValidEndpoint(t *testing.T) {
 				Msg:  "Notification Endpoint Name can't be empty",
 			},
 		},
+		{
+			name: "invalid pagerduty service id",
+			src: &endpoint.PagerDuty{
+				Base:       goodBase,
+				ClientURL:  "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey: influxdb.SecretField{Key: id1 + "-routing-key"},
+				ServiceID:  "PX 12",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `pagerduty service id "PX 12" is invalid`,
+			},
+		},
+		{
+			name: "invalid pagerduty escalation policy id",
+			src: &endpoint.PagerDuty{
+				Base:               goodBase,
+				ClientURL:          "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey:         influxdb.SecretField{Key: id1 + "-routing-key"},
+				EscalationPolicyID: " P7Q3R",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `pagerduty escalation policy id " P7Q3R" is invalid`,
+			},
+		},
+		{
+			name: "pagerduty service and escalation policy",
+			src: &endpoint.PagerDuty{
+				Base:               goodBase,
+				ClientURL:          "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey:         influxdb.SecretField{Key: id1 + "-routing-key"},
+				ServiceID:          "PX12ABC",
+				EscalationPolicyID: "P7Q3R",
+			},
+			err: nil,
+		},
+		{
+			name: "negative rate limit",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:        influxTesting.MustIDBase16Ptr(id1),
+					Name:      "name1",
+					OrgID:     influxTesting.MustIDBase16Ptr(id3),
+					Status:    influxdb.Active,
+					RateLimit: -1,
+				},
+				URL: "localhost",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint RateLimit can't be negative",
+			},
+		},
+		{
+			name: "default title too long",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:           influxTesting.MustIDBase16Ptr(id1),
+					Name:         "name1",
+					OrgID:        influxTesting.MustIDBase16Ptr(id3),
+					Status:       influxdb.Active,
+					DefaultTitle: strings.Repeat("a", 257),
+				},
+				URL: "localhost",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint DefaultTitle can't be longer than 256 characters",
+			},
+		},
+		{
+			name: "footer too long",
+			src: &endpoint.PagerDuty{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					Footer: strings.Repeat("a", 201),
+				},
+				RoutingKey: influxdb.SecretField{Key: id1 + "-routing-key"},
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "Notification Endpoint Footer can't be longer than 200 characters",
+			},
+		},
+		{
+			name: "unknown severity in filter",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:             influxTesting.MustIDBase16Ptr(id1),
+					Name:           "name1",
+					OrgID:          influxTesting.MustIDBase16Ptr(id3),
+					Status:         influxdb.Active,
+					SeverityFilter: []string{"crit", "critical"},
+				},
+				URL: "localhost",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `Notification Endpoint SeverityFilter has unknown severity "critical"`,
+			},
+		},
 		{
 			name: "empty slack url",
 			src: &endpoint.Slack{
@@ -99,6 +263,33 @@ func TestValidEndpoint(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "invalid slack icon emoji",
+			src: &endpoint.Slack{
+				Base:      goodBase,
+				URL:       "localhost",
+				IconEmoji: "warning",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `slack endpoint icon emoji "warning" is invalid`,
+			},
+		},
+		{
+			name: "suspended slack",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:              influxTesting.MustIDBase16Ptr(id1),
+					Name:            "name1",
+					OrgID:           influxTesting.MustIDBase16Ptr(id3),
+					Status:          influxdb.Active,
+					Suspended:       true,
+					SuspendedReason: "too many failures",
+				},
+				URL: "localhost",
+			},
+			err: nil,
+		},
 		{
 			name: "empty http http method",
 			src: &endpoint.HTTP{
@@ -136,6 +327,121 @@ func TestValidEndpoint(t *testing.T) {
 				Msg:  "invalid http username/password for basic auth",
 			},
 		},
+		{
+			name: "http unknown url placeholder",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "https://example.com/alerts/{color}",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `http endpoint URL placeholder "color" is unknown`,
+			},
+		},
+		{
+			name: "http status url placeholder",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "https://example.com/alerts/{severity}",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `http endpoint URL placeholder "severity" is unknown`,
+			},
+		},
+		{
+			name: "http query url placeholder",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "https://example.com/alerts?rule={notificationRuleName}",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "http endpoint URL placeholders are only supported in the path",
+			},
+		},
+		{
+			name: "http templated url",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "https://example.com/alerts/{notificationRuleID}/{notificationRuleName}",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+			},
+			err: nil,
+		},
+		{
+			name: "http broken body template",
+			src: &endpoint.HTTP{
+				Base:         goodBase,
+				URL:          "localhost",
+				Method:       http.MethodPost,
+				AuthMethod:   "none",
+				BodyTemplate: `{"message": "{{ .Message }"}`,
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "http endpoint body template is invalid",
+			},
+		},
+		{
+			name: "http body template",
+			src: &endpoint.HTTP{
+				Base:         goodBase,
+				URL:          "localhost",
+				Method:       http.MethodPost,
+				AuthMethod:   "none",
+				BodyTemplate: `{"message": "{{ .Message }}"}`,
+			},
+			err: nil,
+		},
+		{
+			name: "http client cert without key",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "localhost",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+				ClientCert: &influxdb.SecretField{Key: id1 + "-client-cert", Value: strPtr(cert1)},
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "http client certificate and key must be provided together",
+			},
+		},
+		{
+			name: "mismatched http client cert and key",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "localhost",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+				ClientCert: &influxdb.SecretField{Key: id1 + "-client-cert", Value: strPtr(cert1)},
+				ClientKey:  &influxdb.SecretField{Key: id1 + "-client-key", Value: strPtr(key2)},
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "invalid http client certificate/key pair",
+			},
+		},
+		{
+			name: "http client cert and key",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "localhost",
+				Method:     http.MethodPost,
+				AuthMethod: "none",
+				ClientCert: &influxdb.SecretField{Key: id1 + "-client-cert", Value: strPtr(cert1)},
+				ClientKey:  &influxdb.SecretField{Key: id1 + "-client-key", Value: strPtr(key1)},
+			},
+			err: nil,
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
@@ -145,6 +451,100 @@ func TestValidEndpoint(t *testing.T) {
 	}
 }
 
+func TestValidateAll(t *testing.T) {
+	es := []influxdb.NotificationEndpoint{
+		&endpoint.Slack{
+			Base: goodBase,
+			URL:  "https://hooks.slack.com/services/x/y/z",
+		},
+		&endpoint.Slack{
+			Base: goodBase,
+		},
+		&endpoint.PagerDuty{
+			Base:       goodBase,
+			RoutingKey: influxdb.SecretField{Key: id1 + "-routing-key"},
+		},
+		&endpoint.PagerDuty{
+			Base: goodBase,
+		},
+		&endpoint.HTTP{
+			Base: goodBase,
+			URL:  "localhost",
+		},
+	}
+	want := map[int]error{
+		1: &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "slack endpoint URL must be provided",
+		},
+		3: &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "pagerduty routing key is invalid",
+		},
+		4: &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "invalid http http method",
+		},
+	}
+
+	got := endpoint.ValidateAll(es)
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of errors, want %d, got %d: %v", len(want), len(got), got)
+	}
+	for i, err := range want {
+		influxTesting.ErrorsEqual(t, got[i], err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name string
+		urls []string
+		want string
+	}{
+		{
+			name: "scheme host and default port",
+			urls: []string{
+				"https://hooks.slack.com/services/x/y/z",
+				"HTTPS://Hooks.Slack.com:443/services/x/y/z/",
+			},
+			want: "https://hooks.slack.com/services/x/y/z",
+		},
+		{
+			name: "keep path and query",
+			urls: []string{
+				"http://example.com:8080/Alerts?Severity=crit",
+				"http://EXAMPLE.com:8080/Alerts/?Severity=crit",
+			},
+			want: "http://example.com:8080/Alerts?Severity=crit",
+		},
+		{
+			name: "strip http default port",
+			urls: []string{
+				"http://example.com",
+				"http://example.com:80/",
+			},
+			want: "http://example.com",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, u := range c.urls {
+				s := &endpoint.Slack{URL: u}
+				s.Normalize()
+				if s.URL != c.want {
+					t.Errorf("slack url %q normalized to %q, want %q", u, s.URL, c.want)
+				}
+				h := &endpoint.HTTP{URL: u}
+				h.Normalize()
+				if h.URL != c.want {
+					t.Errorf("http url %q normalized to %q, want %q", u, h.URL, c.want)
+				}
+			}
+		})
+	}
+}
+
 var timeGen1 = mock.TimeGenerator{FakeValue: time.Date(2006, time.July, 13, 4, 19, 10, 0, time.UTC)}
 var timeGen2 = mock.TimeGenerator{FakeValue: time.Date(2006, time.July, 14, 5, 23, 53, 10, time.UTC)}
 
@@ -186,6 +586,24 @@ func TestJSON(t *testing.T) {
 				URL: "https://hooks.slack.com/services/x/y/z",
 			},
 		},
+		{
+			name: "Slack with username and icon emoji",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				URL:       "https://hooks.slack.com/services/x/y/z",
+				Username:  "influxdb",
+				IconEmoji: ":warning:",
+			},
+		},
 		{
 			name: "simple pagerduty",
 			src: &endpoint.PagerDuty{
@@ -203,6 +621,44 @@ func TestJSON(t *testing.T) {
 				RoutingKey: influxdb.SecretField{Key: "pagerduty-routing-key"},
 			},
 		},
+		{
+			name: "pagerduty with service and escalation policy",
+			src: &endpoint.PagerDuty{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				ClientURL:          "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey:         influxdb.SecretField{Key: "pagerduty-routing-key"},
+				ServiceID:          "PX12ABC",
+				EscalationPolicyID: "P7Q3R",
+			},
+		},
+		{
+			name: "suspended pagerduty",
+			src: &endpoint.PagerDuty{
+				Base: endpoint.Base{
+					ID:              influxTesting.MustIDBase16Ptr(id1),
+					Name:            "name1",
+					OrgID:           influxTesting.MustIDBase16Ptr(id3),
+					Status:          influxdb.Active,
+					Suspended:       true,
+					SuspendedReason: "too many failures",
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				ClientURL:  "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey: influxdb.SecretField{Key: "pagerduty-routing-key"},
+			},
+		},
 		{
 			name: "simple http",
 			src: &endpoint.HTTP{
@@ -226,6 +682,137 @@ func TestJSON(t *testing.T) {
 				Password:   influxdb.SecretField{Key: "password-key"},
 			},
 		},
+		{
+			name: "http with timeout",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				AuthMethod: "none",
+				Method:     http.MethodPost,
+				URL:        "http://example.com",
+				Timeout:    &influxdb.Duration{Duration: 30 * time.Second},
+			},
+		},
+		{
+			name: "http with client cert",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				AuthMethod: "none",
+				Method:     http.MethodPost,
+				URL:        "https://example.com",
+				ClientCert: &influxdb.SecretField{Key: "client-cert-key"},
+				ClientKey:  &influxdb.SecretField{Key: "client-key-key"},
+			},
+		},
+		{
+			name: "http with body template",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				AuthMethod:   "none",
+				Method:       http.MethodPost,
+				URL:          "https://example.com",
+				BodyTemplate: `{"message": "{{ .Message }}"}`,
+			},
+		},
+		{
+			name: "http with rate limit",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:        influxTesting.MustIDBase16Ptr(id1),
+					Name:      "name1",
+					OrgID:     influxTesting.MustIDBase16Ptr(id3),
+					Status:    influxdb.Active,
+					RateLimit: 30,
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				AuthMethod: "none",
+				Method:     http.MethodPost,
+				URL:        "http://example.com",
+			},
+		},
+		{
+			name: "pagerduty with footer",
+			src: &endpoint.PagerDuty{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+					Footer: "Sent by AcmeMonitor",
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				ClientURL:  "https://events.pagerduty.com/v2/enqueue",
+				RoutingKey: influxdb.SecretField{Key: "pagerduty-routing-key"},
+			},
+		},
+		{
+			name: "http with severity filter",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:             influxTesting.MustIDBase16Ptr(id1),
+					Name:           "name1",
+					OrgID:          influxTesting.MustIDBase16Ptr(id3),
+					Status:         influxdb.Active,
+					SeverityFilter: []string{"crit", "warn"},
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				AuthMethod: "none",
+				Method:     http.MethodPost,
+				URL:        "http://example.com",
+			},
+		},
+		{
+			name: "slack with default title",
+			src: &endpoint.Slack{
+				Base: endpoint.Base{
+					ID:           influxTesting.MustIDBase16Ptr(id1),
+					Name:         "name1",
+					OrgID:        influxTesting.MustIDBase16Ptr(id3),
+					Status:       influxdb.Active,
+					DefaultTitle: "InfluxDB alert",
+					CRUDLog: influxdb.CRUDLog{
+						CreatedAt: timeGen1.Now(),
+						UpdatedAt: timeGen2.Now(),
+					},
+				},
+				URL: "https://hooks.slack.com/services/x/y/z",
+			},
+		},
 	}
 	for _, c := range cases {
 		b, err := json.Marshal(c.src)
@@ -242,6 +829,268 @@ func TestJSON(t *testing.T) {
 	}
 }
 
+func TestJSONDuration(t *testing.T) {
+	src := &endpoint.HTTP{
+		Base:       goodBase,
+		AuthMethod: "none",
+		Method:     http.MethodPost,
+		URL:        "http://example.com",
+		Timeout:    &influxdb.Duration{Duration: 30 * time.Second},
+	}
+	b, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshal failed, err: %s", err.Error())
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		t.Fatalf("unmarshal failed, err: %s", err.Error())
+	}
+	if raw["timeout"] != "30s" {
+		t.Errorf("expected timeout to be marshaled as %q, got %v", "30s", raw["timeout"])
+	}
+
+	for _, timeout := range []string{`"banana"`, `true`, `{}`} {
+		_, err = endpoint.UnmarshalJSON([]byte(`{"type":"http","timeout":` + timeout + `}`))
+		if code := influxdb.ErrorCode(err); code != influxdb.EInvalid {
+			t.Errorf("timeout %s: expected error code %q, got %q (%v)", timeout, influxdb.EInvalid, code, err)
+		}
+	}
+}
+
+func TestRenderPayload(t *testing.T) {
+	cases := []struct {
+		name string
+		src  interface {
+			RenderPayload(msg string) ([]byte, error)
+		}
+		want string
+	}{
+		{
+			name: "slack",
+			src: &endpoint.Slack{
+				Base:      goodBase,
+				URL:       "https://hooks.slack.com/services/x/y/z",
+				Token:     influxdb.SecretField{Key: "token-key-1", Value: strPtr("token-value")},
+				IconEmoji: ":warning:",
+			},
+			want: `{"channel":"","attachments":[{"color":"","text":"cpu is high","mrkdwn_in":["text"]}],"as_user":false}`,
+		},
+		{
+			name: "pagerduty",
+			src: &endpoint.PagerDuty{
+				Base:       goodBase,
+				ClientURL:  "http://localhost:9999",
+				RoutingKey: influxdb.SecretField{Key: "pagerduty-routing-key", Value: strPtr("routing-key-value")},
+			},
+			want: `{"payload":{"summary":"cpu is high","timestamp":"","source":"","severity":"","group":"","class":""},"routing_key":"secret: pagerduty-routing-key","dedup_key":"","event_action":"","client":"influxdata","client_url":"http://localhost:9999"}`,
+		},
+		{
+			name: "http",
+			src: &endpoint.HTTP{
+				Base:       goodBase,
+				URL:        "http://example.com",
+				Method:     http.MethodPost,
+				AuthMethod: "bearer",
+				Token:      influxdb.SecretField{Key: "token-key-1", Value: strPtr("token-value")},
+			},
+			want: `{"_message":"cpu is high","_version":1}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := c.src.RenderPayload("cpu is high")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if string(got) != c.want {
+				t.Errorf("unexpected payload, want %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	h := &endpoint.HTTP{
+		Base:         goodBase,
+		URL:          "https://example.com",
+		BodyTemplate: `{"check": "{{ .CheckName }}", "level": "{{ .Level }}"}`,
+	}
+	got, err := h.Render(struct {
+		CheckName string
+		Level     string
+	}{
+		CheckName: "cpu",
+		Level:     "crit",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	want := `{"check": "cpu", "level": "crit"}`
+	if string(got) != want {
+		t.Errorf("unexpected body, want %s, got %s", want, got)
+	}
+}
+
+func TestAcceptsSeverity(t *testing.T) {
+	all := endpoint.Base{}
+	for _, sev := range []string{"crit", "warn", "info", "ok"} {
+		if !all.AcceptsSeverity(sev) {
+			t.Errorf("empty severity filter should accept %s", sev)
+		}
+	}
+	filtered := endpoint.Base{SeverityFilter: []string{"crit", "warn"}}
+	if !filtered.AcceptsSeverity("crit") {
+		t.Errorf("severity filter should accept crit")
+	}
+	if filtered.AcceptsSeverity("info") {
+		t.Errorf("severity filter should not accept info")
+	}
+}
+
+func TestRenderURL(t *testing.T) {
+	cases := []struct {
+		name string
+		url  string
+		vars map[string]string
+		want string
+		err  error
+	}{
+		{
+			name: "no placeholder",
+			url:  "https://example.com/alerts",
+			want: "https://example.com/alerts",
+		},
+		{
+			name: "escaped substitution",
+			url:  "https://example.com/alerts/{notificationRuleID}/{notificationRuleName}",
+			vars: map[string]string{
+				endpoint.HTTPURLRuleID:   "020f755c3c082000",
+				endpoint.HTTPURLRuleName: "cpu/high usage",
+			},
+			want: "https://example.com/alerts/020f755c3c082000/cpu%2Fhigh%20usage",
+		},
+		{
+			name: "placeholder without value",
+			url:  "https://example.com/alerts/{notificationRuleName}",
+			vars: map[string]string{
+				endpoint.HTTPURLRuleID: "020f755c3c082000",
+			},
+			err: &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  `http endpoint URL placeholder "notificationRuleName" has no value`,
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := &endpoint.HTTP{Base: goodBase, URL: c.url}
+			h.Normalize()
+			got, err := h.RenderURL(c.vars)
+			influxTesting.ErrorsEqual(t, err, c.err)
+			if got != c.want {
+				t.Errorf("unexpected url, want %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSchemaFor(t *testing.T) {
+	schema, err := endpoint.SchemaFor(endpoint.HTTPType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for key, want := range map[string]endpoint.FieldSpec{
+		"authMethod": {
+			Name:     "AuthMethod",
+			JSONKey:  "authMethod",
+			Required: true,
+			Enum:     []string{"basic", "bearer", "none"},
+		},
+		"password": {
+			Name:    "Password",
+			JSONKey: "password",
+			Secret:  true,
+		},
+		"clientKey": {
+			Name:    "ClientKey",
+			JSONKey: "clientKey",
+			Secret:  true,
+		},
+		"name": {
+			Name:     "Name",
+			JSONKey:  "name",
+			Required: true,
+		},
+		"orgID": {
+			Name:     "OrgID",
+			JSONKey:  "orgID",
+			Required: true,
+		},
+	} {
+		if diff := cmp.Diff(want, schema[key]); diff != "" {
+			t.Errorf("failed %s, FieldSpec are different -want/+got\ndiff %s", key, diff)
+		}
+	}
+	if _, ok := schema["createdAt"]; ok {
+		t.Errorf("expected crud log fields to be excluded from the schema")
+	}
+
+	_, err = endpoint.SchemaFor("banana")
+	influxTesting.ErrorsEqual(t, err, &influxdb.Error{
+		Code: influxdb.EInvalid,
+		Msg:  "invalid notification endpoint type banana",
+	})
+}
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		name string
+		src  influxdb.NotificationEndpoint
+		want string
+	}{
+		{
+			name: "slack",
+			src: &endpoint.Slack{
+				Base:  goodBase,
+				URL:   "https://hooks.slack.com/services/x/y/z",
+				Token: influxdb.SecretField{Key: "token-key-1", Value: strPtr("token-value")},
+			},
+			want: "slack/name1/" + id1 + "/active",
+		},
+		{
+			name: "pagerduty",
+			src: &endpoint.PagerDuty{
+				Base:       goodBase,
+				RoutingKey: influxdb.SecretField{Key: "pagerduty-routing-key", Value: strPtr("routing-key-value")},
+			},
+			want: "pagerduty/name1/" + id1 + "/active",
+		},
+		{
+			name: "http",
+			src: &endpoint.HTTP{
+				Base:     goodBase,
+				URL:      "http://example.com",
+				Username: influxdb.SecretField{Key: "username-key", Value: strPtr("username1")},
+				Password: influxdb.SecretField{Key: "password-key", Value: strPtr("password1")},
+			},
+			want: "http/name1/" + id1 + "/active",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := fmt.Sprintf("%v", c.src)
+			if got != c.want {
+				t.Errorf("unexpected string, want %q, got %q", c.want, got)
+			}
+			for _, sf := range c.src.SecretFields() {
+				if strings.Contains(got, *sf.Value) {
+					t.Errorf("string %q contains secret value %q", got, *sf.Value)
+				}
+			}
+		})
+	}
+}
+
 func TestBackFill(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -365,6 +1214,43 @@ func TestBackFill(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "http with client cert",
+			src: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+				},
+				AuthMethod: "none",
+				URL:        "https://example.com",
+				ClientCert: &influxdb.SecretField{
+					Value: strPtr("cert1"),
+				},
+				ClientKey: &influxdb.SecretField{
+					Value: strPtr("key1"),
+				},
+			},
+			target: &endpoint.HTTP{
+				Base: endpoint.Base{
+					ID:     influxTesting.MustIDBase16Ptr(id1),
+					Name:   "name1",
+					OrgID:  influxTesting.MustIDBase16Ptr(id3),
+					Status: influxdb.Active,
+				},
+				AuthMethod: "none",
+				URL:        "https://example.com",
+				ClientCert: &influxdb.SecretField{
+					Key:   id1 + "-client-cert",
+					Value: strPtr("cert1"),
+				},
+				ClientKey: &influxdb.SecretField{
+					Key:   id1 + "-client-key",
+					Value: strPtr("key1"),
+				},
+			},
+		},
 	}
 	for _, c := range cases {
 		c.src.BackfillSecretKeys()
@@ -379,3 +1265,28 @@ func strPtr(s string) *string {
 	*ss = s
 	return ss
 }
+
+// mustPEMKeyPair generates a PEM encoded self-signed certificate and its private key.
+func mustPEMKeyPair() (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "influxdb"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	priv := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(priv)
+}
@@ -1,11 +1,15 @@
 package endpoint
 
 import (
+	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"regexp"
+	"text/template"
 
 	"github.com/influxdata/influxdb/v2"
 )
@@ -13,15 +17,21 @@ import (
 var _ influxdb.NotificationEndpoint = &HTTP{}
 
 const (
-	httpTokenSuffix    = "-token"
-	httpUsernameSuffix = "-username"
-	httpPasswordSuffix = "-password"
+	httpTokenSuffix      = "-token"
+	httpUsernameSuffix   = "-username"
+	httpPasswordSuffix   = "-password"
+	httpClientCertSuffix = "-client-cert"
+	httpClientKeySuffix  = "-client-key"
 )
 
 // HTTP is the notification endpoint config of http.
 type HTTP struct {
 	Base
 	// Path is the API path of HTTP
+	// The path may contain {notificationRuleID} and {notificationRuleName}
+	// placeholders. Only rule variables are supported, the notification rule
+	// task posts every status to one url, so status values such as the
+	// severity or check can't be substituted.
 	URL string `json:"url"`
 	// Token is the bearer token for authorization
 	Headers         map[string]string    `json:"headers,omitempty"`
@@ -31,6 +41,17 @@ type HTTP struct {
 	AuthMethod      string               `json:"authMethod"`
 	Method          string               `json:"method"`
 	ContentTemplate string               `json:"contentTemplate"`
+	// Timeout is the maximum duration to wait for the http request.
+	// It is validated and stored, but the notification rule doesn't use it yet.
+	Timeout *influxdb.Duration `json:"timeout,omitempty"`
+	// ClientCert and ClientKey are the PEM encoded client certificate
+	// and private key for mutual TLS, they are left out when not set.
+	ClientCert *influxdb.SecretField `json:"clientCert,omitempty"`
+	ClientKey  *influxdb.SecretField `json:"clientKey,omitempty"`
+	// BodyTemplate is the text/template used to render the request body.
+	// It is validated and stored, but the notification rule doesn't use it
+	// yet, the generated task always posts the status record.
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
 }
 
 // BackfillSecretKeys fill back fill the secret field key during the unmarshalling
@@ -45,6 +66,21 @@ func (s *HTTP) BackfillSecretKeys() {
 	if s.Password.Key == "" && s.Password.Value != nil {
 		s.Password.Key = s.idStr() + httpPasswordSuffix
 	}
+	if s.ClientCert != nil && s.ClientCert.Key == "" && s.ClientCert.Value != nil {
+		s.ClientCert.Key = s.idStr() + httpClientCertSuffix
+	}
+	if s.ClientKey != nil && s.ClientKey.Key == "" && s.ClientKey.Value != nil {
+		s.ClientKey.Key = s.idStr() + httpClientKeySuffix
+	}
+}
+
+// Normalize canonicalizes the URL, so equivalent urls compare equal.
+// Templated URLs are left untouched, escaping would mangle the placeholders.
+func (s *HTTP) Normalize() {
+	if httpURLPlaceholder.MatchString(s.URL) {
+		return
+	}
+	s.URL = normalizeURL(s.URL)
 }
 
 // SecretFields return available secret fields.
@@ -59,6 +95,12 @@ func (s HTTP) SecretFields() []influxdb.SecretField {
 	if s.Password.Key != "" {
 		arr = append(arr, s.Password)
 	}
+	if s.ClientCert != nil && s.ClientCert.Key != "" {
+		arr = append(arr, *s.ClientCert)
+	}
+	if s.ClientKey != nil && s.ClientKey.Key != "" {
+		arr = append(arr, *s.ClientKey)
+	}
 	return arr
 }
 
@@ -74,6 +116,23 @@ var goodHTTPMethod = map[string]bool{
 	http.MethodPut:  true,
 }
 
+// httpURLPlaceholder matches the {var} placeholders of a templated URL.
+var httpURLPlaceholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// HTTP URL placeholders, they are substituted by the notification rule
+// when it generates the flux of its task.
+const (
+	HTTPURLRuleID   = "notificationRuleID"
+	HTTPURLRuleName = "notificationRuleName"
+)
+
+// httpURLVariables are the variables a templated URL can reference. The url of
+// the generated task is fixed, so only the variables of the rule are known.
+var httpURLVariables = map[string]bool{
+	HTTPURLRuleID:   true,
+	HTTPURLRuleName: true,
+}
+
 // Valid returns error if some configuration is invalid
 func (s HTTP) Valid() error {
 	if err := s.Base.valid(); err != nil {
@@ -85,12 +144,29 @@ func (s HTTP) Valid() error {
 			Msg:  "http endpoint URL is empty",
 		}
 	}
-	if _, err := url.Parse(s.URL); err != nil {
+	u, err := url.Parse(s.URL)
+	if err != nil {
 		return &influxdb.Error{
 			Code: influxdb.EInvalid,
 			Msg:  fmt.Sprintf("http endpoint URL is invalid: %s", err.Error()),
 		}
 	}
+	placeholders := httpURLPlaceholder.FindAllStringSubmatch(s.URL, -1)
+	// values are path escaped, so placeholders elsewhere could inject query params
+	if len(placeholders) != len(httpURLPlaceholder.FindAllString(u.Path, -1)) {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "http endpoint URL placeholders are only supported in the path",
+		}
+	}
+	for _, m := range placeholders {
+		if !httpURLVariables[m[1]] {
+			return &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  fmt.Sprintf("http endpoint URL placeholder %q is unknown", m[1]),
+			}
+		}
+	}
 	if !goodHTTPMethod[s.Method] {
 		return &influxdb.Error{
 			Code: influxdb.EInvalid,
@@ -115,6 +191,36 @@ func (s HTTP) Valid() error {
 			Msg:  "invalid http token for bearer auth",
 		}
 	}
+	if (s.ClientCert == nil || s.ClientCert.Key == "") != (s.ClientKey == nil || s.ClientKey.Key == "") {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "http client certificate and key must be provided together",
+		}
+	}
+	if s.ClientCert != nil && s.ClientKey != nil && s.ClientCert.Value != nil && s.ClientKey.Value != nil {
+		if _, err := tls.X509KeyPair([]byte(*s.ClientCert.Value), []byte(*s.ClientKey.Value)); err != nil {
+			return &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "invalid http client certificate/key pair",
+				Err:  err,
+			}
+		}
+	}
+	if s.BodyTemplate != "" {
+		if _, err := s.bodyTemplate(); err != nil {
+			return &influxdb.Error{
+				Code: influxdb.EInvalid,
+				Msg:  "http endpoint body template is invalid",
+				Err:  err,
+			}
+		}
+	}
+	if s.Timeout != nil && s.Timeout.Duration < 0 {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "http endpoint timeout can't be negative",
+		}
+	}
 
 	return nil
 }
@@ -132,11 +238,75 @@ func (s HTTP) MarshalJSON() ([]byte, error) {
 		})
 }
 
+// String implements fmt.Stringer interface.
+func (s HTTP) String() string {
+	return s.Base.logString(s.Type())
+}
+
 // Type returns the type.
 func (s HTTP) Type() string {
 	return HTTPType
 }
 
+// RenderPayload returns a partial preview of the body sent for msg, without
+// sending it. The notification rule posts the whole status record, the
+// preview only has its _message and _version, the tag and field columns
+// depend on the check and are left out.
+func (s HTTP) RenderPayload(msg string) ([]byte, error) {
+	return json.Marshal(struct {
+		Message string `json:"_message"`
+		Version int    `json:"_version"`
+	}{
+		Message: msg,
+		Version: 1,
+	})
+}
+
+// RenderURL substitutes the {var} placeholders of the URL with the escaped vars.
+func (s HTTP) RenderURL(vars map[string]string) (string, error) {
+	var err error
+	u := httpURLPlaceholder.ReplaceAllStringFunc(s.URL, func(p string) string {
+		name := p[1 : len(p)-1]
+		v, ok := vars[name]
+		if !ok {
+			if err == nil {
+				err = &influxdb.Error{
+					Code: influxdb.EInvalid,
+					Msg:  fmt.Sprintf("http endpoint URL placeholder %q has no value", name),
+				}
+			}
+			return p
+		}
+		return url.PathEscape(v)
+	})
+	if err != nil {
+		return "", err
+	}
+	return u, nil
+}
+
+func (s HTTP) bodyTemplate() (*template.Template, error) {
+	return template.New("body").Parse(s.BodyTemplate)
+}
+
+// Render executes the body template with data.
+// The notification rule doesn't call it, see BodyTemplate.
+func (s HTTP) Render(data interface{}) ([]byte, error) {
+	tmpl, err := s.bodyTemplate()
+	if err != nil {
+		return nil, &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  "http endpoint body template is invalid",
+			Err:  err,
+		}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // ParseResponse will parse the http response from http.
 func (s HTTP) ParseResponse(resp *http.Response) error {
 	if resp.StatusCode != http.StatusOK {
@@ -2,6 +2,8 @@ package endpoint
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
 
 	"github.com/influxdata/influxdb/v2"
 )
@@ -18,8 +20,14 @@ type PagerDuty struct {
 	// RoutingKey is a version 4 UUID expressed as a 32-digit hexadecimal number.
 	// This is the Integration Key for an integration on any given service.
 	RoutingKey influxdb.SecretField `json:"routingKey"`
+	// ServiceID and EscalationPolicyID optionally target a specific
+	// PagerDuty service and escalation policy.
+	ServiceID          string `json:"serviceID,omitempty"`
+	EscalationPolicyID string `json:"escalationPolicyID,omitempty"`
 }
 
+var pagerdutyIDPattern = regexp.MustCompile(`^[[:alnum:]]+$`)
+
 // BackfillSecretKeys fill back fill the secret field key during the unmarshalling
 // if value of that secret field is not nil.
 func (s *PagerDuty) BackfillSecretKeys() {
@@ -46,6 +54,18 @@ func (s PagerDuty) Valid() error {
 			Msg:  "pagerduty routing key is invalid",
 		}
 	}
+	if s.ServiceID != "" && !pagerdutyIDPattern.MatchString(s.ServiceID) {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("pagerduty service id %q is invalid", s.ServiceID),
+		}
+	}
+	if s.EscalationPolicyID != "" && !pagerdutyIDPattern.MatchString(s.EscalationPolicyID) {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("pagerduty escalation policy id %q is invalid", s.EscalationPolicyID),
+		}
+	}
 	return nil
 }
 
@@ -63,7 +83,39 @@ func (s PagerDuty) MarshalJSON() ([]byte, error) {
 		})
 }
 
+// String implements fmt.Stringer interface.
+func (s PagerDuty) String() string {
+	return s.Base.logString(s.Type())
+}
+
 // Type returns the type.
 func (s PagerDuty) Type() string {
 	return PagerDutyType
 }
+
+// RenderPayload returns the pagerduty event that would be sent for msg,
+// without sending it. The routing key is referenced by its secret key.
+// Fields the notification rule sets from the status are empty.
+func (s PagerDuty) RenderPayload(msg string) ([]byte, error) {
+	type payload struct {
+		Summary   string `json:"summary"`
+		Timestamp string `json:"timestamp"`
+		Source    string `json:"source"`
+		Severity  string `json:"severity"`
+		Group     string `json:"group"`
+		Class     string `json:"class"`
+	}
+	return json.Marshal(struct {
+		Payload     payload `json:"payload"`
+		RoutingKey  string  `json:"routing_key"`
+		DedupKey    string  `json:"dedup_key"`
+		EventAction string  `json:"event_action"`
+		Client      string  `json:"client"`
+		ClientURL   string  `json:"client_url"`
+	}{
+		Payload:    payload{Summary: msg},
+		RoutingKey: s.RoutingKey.String(),
+		Client:     "influxdata",
+		ClientURL:  s.ClientURL,
+	})
+}
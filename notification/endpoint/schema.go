@@ -0,0 +1,109 @@
+package endpoint
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/v2"
+)
+
+// FieldSpec describes a field of a notification endpoint,
+// it is used by the UI to generate the settings form.
+type FieldSpec struct {
+	Name     string   `json:"name"`
+	JSONKey  string   `json:"jsonKey"`
+	Secret   bool     `json:"secret"`
+	Required bool     `json:"required"`
+	Enum     []string `json:"enum,omitempty"`
+}
+
+type fieldMeta struct {
+	required bool
+	enum     []string
+}
+
+var baseFieldMeta = map[string]fieldMeta{
+	"name":   {required: true},
+	"orgID":  {required: true},
+	"status": {required: true, enum: []string{string(influxdb.Active), string(influxdb.Inactive)}},
+}
+
+// typeToFieldMeta is the metadata of each endpoint type which can't be
+// derived from the struct, keyed by json key.
+var typeToFieldMeta = map[string]map[string]fieldMeta{
+	SlackType: {
+		"url": {required: true},
+	},
+	PagerDutyType: {
+		"routingKey": {required: true},
+	},
+	HTTPType: {
+		"url":        {required: true},
+		"method":     {required: true, enum: sortedKeys(goodHTTPMethod)},
+		"authMethod": {required: true, enum: sortedKeys(goodHTTPAuthMethod)},
+	},
+}
+
+var (
+	secretFieldType = reflect.TypeOf(influxdb.SecretField{})
+	crudLogType     = reflect.TypeOf(influxdb.CRUDLog{})
+)
+
+// SchemaFor returns the field specs of the notification endpoint type keyed by json key.
+func SchemaFor(typ string) (map[string]FieldSpec, error) {
+	newEndpoint, ok := typeToEndpoint[typ]
+	if !ok {
+		return nil, &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("invalid notification endpoint type %s", typ),
+		}
+	}
+	schema := make(map[string]FieldSpec)
+	addFieldSpecs(schema, reflect.TypeOf(newEndpoint()).Elem(), typeToFieldMeta[typ])
+	return schema, nil
+}
+
+func addFieldSpecs(schema map[string]FieldSpec, t reflect.Type, meta map[string]fieldMeta) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			if f.Type == crudLogType {
+				continue
+			}
+			if f.Type.Kind() == reflect.Struct {
+				addFieldSpecs(schema, f.Type, baseFieldMeta)
+				continue
+			}
+		}
+		key := strings.Split(f.Tag.Get("json"), ",")[0]
+		if key == "-" || f.PkgPath != "" {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		m := meta[key]
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		schema[key] = FieldSpec{
+			Name:     f.Name,
+			JSONKey:  key,
+			Secret:   ft == secretFieldType,
+			Required: m.required,
+			Enum:     m.enum,
+		}
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
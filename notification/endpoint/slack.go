@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"github.com/influxdata/influxdb/v2"
 )
@@ -21,8 +22,15 @@ type Slack struct {
 	URL string `json:"url"`
 	// Token is the bearer token for authorization
 	Token influxdb.SecretField `json:"token"`
+	// Username is the display name of the bot.
+	// Username and IconEmoji are stored, but the notification rule doesn't send them yet.
+	Username string `json:"username,omitempty"`
+	// IconEmoji is the avatar of the bot, example: :warning:
+	IconEmoji string `json:"iconEmoji,omitempty"`
 }
 
+var slackEmojiPattern = regexp.MustCompile(`^:[a-z0-9_+\-]+:$`)
+
 // BackfillSecretKeys fill back fill the secret field key during the unmarshalling
 // if value of that secret field is not nil.
 func (s *Slack) BackfillSecretKeys() {
@@ -31,6 +39,11 @@ func (s *Slack) BackfillSecretKeys() {
 	}
 }
 
+// Normalize canonicalizes the URL, so equivalent urls compare equal.
+func (s *Slack) Normalize() {
+	s.URL = normalizeURL(s.URL)
+}
+
 // SecretFields return available secret fields.
 func (s Slack) SecretFields() []influxdb.SecretField {
 	arr := []influxdb.SecretField{}
@@ -59,6 +72,12 @@ func (s Slack) Valid() error {
 			}
 		}
 	}
+	if s.IconEmoji != "" && !slackEmojiPattern.MatchString(s.IconEmoji) {
+		return &influxdb.Error{
+			Code: influxdb.EInvalid,
+			Msg:  fmt.Sprintf("slack endpoint icon emoji %q is invalid", s.IconEmoji),
+		}
+	}
 	return nil
 }
 
@@ -76,7 +95,33 @@ func (s Slack) MarshalJSON() ([]byte, error) {
 		})
 }
 
+// String implements fmt.Stringer interface.
+func (s Slack) String() string {
+	return s.Base.logString(s.Type())
+}
+
 // Type returns the type.
 func (s Slack) Type() string {
 	return SlackType
 }
+
+// RenderPayload returns the slack message body that would be sent for msg,
+// without sending it. The channel and color are set by the notification
+// rule from the status, so they are empty.
+func (s Slack) RenderPayload(msg string) ([]byte, error) {
+	type attachment struct {
+		Color    string   `json:"color"`
+		Text     string   `json:"text"`
+		MrkdwnIn []string `json:"mrkdwn_in"`
+	}
+	return json.Marshal(struct {
+		Channel     string       `json:"channel"`
+		Attachments []attachment `json:"attachments"`
+		AsUser      bool         `json:"as_user"`
+	}{
+		Attachments: []attachment{{
+			Text:     msg,
+			MrkdwnIn: []string{"text"},
+		}},
+	})
+}
@@ -30,6 +30,17 @@ func (s *HTTP) GenerateFlux(e influxdb.NotificationEndpoint) (string, error) {
 
 // GenerateFluxAST generates a flux AST for the http notification rule.
 func (s *HTTP) GenerateFluxAST(e *endpoint.HTTP) (*ast.Package, error) {
+	u, err := e.RenderURL(map[string]string{
+		endpoint.HTTPURLRuleID:   s.ID.String(),
+		endpoint.HTTPURLRuleName: s.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rendered := *e
+	rendered.URL = u
+	e = &rendered
+
 	f := flux.File(
 		s.Name,
 		s.imports(e),
@@ -77,6 +77,74 @@ all_statuses
 	}
 }
 
+func TestHTTP_GenerateFlux_templatedURL(t *testing.T) {
+	want := `package main
+// foo
+import "influxdata/influxdb/monitor"
+import "http"
+import "json"
+import "experimental"
+
+option task = {name: "foo", every: 1h, offset: 1s}
+
+headers = {"Content-Type": "application/json"}
+endpoint = http["endpoint"](url: "http://localhost:7777/rules/0000000000000001")
+notification = {
+	_notification_rule_id: "0000000000000001",
+	_notification_rule_name: "foo",
+	_notification_endpoint_id: "0000000000000002",
+	_notification_endpoint_name: "foo",
+}
+statuses = monitor["from"](start: -2h)
+crit = statuses
+	|> filter(fn: (r) =>
+		(r["_level"] == "crit"))
+all_statuses = crit
+	|> filter(fn: (r) =>
+		(r["_time"] > experimental["subDuration"](from: now(), d: 1h)))
+
+all_statuses
+	|> monitor["notify"](data: notification, endpoint: endpoint(mapFn: (r) => {
+		body = {r with _version: 1}
+
+		return {headers: headers, data: json["encode"](v: body)}
+	}))`
+
+	s := &rule.HTTP{
+		Base: rule.Base{
+			ID:         1,
+			Name:       "foo",
+			Every:      mustDuration("1h"),
+			Offset:     mustDuration("1s"),
+			EndpointID: 2,
+			TagRules:   []notification.TagRule{},
+			StatusRules: []notification.StatusRule{
+				{
+					CurrentLevel: notification.Critical,
+				},
+			},
+		},
+	}
+
+	id := influxdb.ID(2)
+	e := &endpoint.HTTP{
+		Base: endpoint.Base{
+			ID:   &id,
+			Name: "foo",
+		},
+		URL: "http://localhost:7777/rules/{notificationRuleID}",
+	}
+
+	f, err := s.GenerateFlux(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if f != want {
+		t.Errorf("scripts did not match. want:\n%v\n\ngot:\n%v", want, f)
+	}
+}
+
 func TestHTTP_GenerateFlux_basicAuth(t *testing.T) {
 	want := `package main
 // foo
@@ -257,6 +257,16 @@ func decodePluginRaw(tcd *telegrafConfigDecode) ([]string, string, error) {
 			}
 		}
 
+		if v, ok := config.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return nil, "", &Error{
+					Code: EInvalid,
+					Err:  err,
+					Op:   op,
+				}
+			}
+		}
+
 		if pr.Name == "influxdb_v2" {
 			if b := config.(*outputs.InfluxDBV2).Bucket; b != "" {
 				bucket = []string{b}
@@ -291,29 +301,39 @@ type telegrafPluginDecode struct {
 }
 
 var availableInputPlugins = map[string](func() plugins.Config){
-	"cpu":          func() plugins.Config { return &inputs.CPUStats{} },
-	"disk":         func() plugins.Config { return &inputs.DiskStats{} },
-	"diskio":       func() plugins.Config { return &inputs.DiskIO{} },
-	"docker":       func() plugins.Config { return &inputs.Docker{} },
-	"file":         func() plugins.Config { return &inputs.File{} },
-	"kernel":       func() plugins.Config { return &inputs.Kernel{} },
-	"kubernetes":   func() plugins.Config { return &inputs.Kubernetes{} },
-	"logparser":    func() plugins.Config { return &inputs.LogParserPlugin{} },
-	"mem":          func() plugins.Config { return &inputs.MemStats{} },
-	"net_response": func() plugins.Config { return &inputs.NetResponse{} },
-	"net":          func() plugins.Config { return &inputs.NetIOStats{} },
-	"nginx":        func() plugins.Config { return &inputs.Nginx{} },
-	"processes":    func() plugins.Config { return &inputs.Processes{} },
-	"procstat":     func() plugins.Config { return &inputs.Procstat{} },
-	"prometheus":   func() plugins.Config { return &inputs.Prometheus{} },
-	"redis":        func() plugins.Config { return &inputs.Redis{} },
-	"swap":         func() plugins.Config { return &inputs.SwapStats{} },
-	"syslog":       func() plugins.Config { return &inputs.Syslog{} },
-	"system":       func() plugins.Config { return &inputs.SystemStats{} },
-	"tail":         func() plugins.Config { return &inputs.Tail{} },
+	"cpu":               func() plugins.Config { return &inputs.CPUStats{} },
+	"disk":              func() plugins.Config { return &inputs.DiskStats{} },
+	"diskio":            func() plugins.Config { return &inputs.DiskIO{} },
+	"docker":            func() plugins.Config { return &inputs.Docker{} },
+	"file":              func() plugins.Config { return &inputs.File{} },
+	"kernel":            func() plugins.Config { return &inputs.Kernel{} },
+	"kube_inventory":    func() plugins.Config { return &inputs.KubeInventoryStats{} },
+	"kubernetes":        func() plugins.Config { return &inputs.Kubernetes{} },
+	"logparser":         func() plugins.Config { return &inputs.LogParserPlugin{} },
+	"mem":               func() plugins.Config { return &inputs.MemStats{} },
+	"mqtt_consumer":     func() plugins.Config { return &inputs.MQTTConsumerStats{} },
+	"mysql":             func() plugins.Config { return &inputs.MySQLStats{} },
+	"net_response":      func() plugins.Config { return &inputs.NetResponse{} },
+	"net":               func() plugins.Config { return &inputs.NetIOStats{} },
+	"nginx":             func() plugins.Config { return &inputs.Nginx{} },
+	"ping":              func() plugins.Config { return &inputs.PingStats{} },
+	"processes":         func() plugins.Config { return &inputs.Processes{} },
+	"procstat":          func() plugins.Config { return &inputs.Procstat{} },
+	"prometheus":        func() plugins.Config { return &inputs.Prometheus{} },
+	"redis":             func() plugins.Config { return &inputs.Redis{} },
+	"socket_listener":   func() plugins.Config { return &inputs.SocketListenerStats{} },
+	"swap":              func() plugins.Config { return &inputs.SwapStats{} },
+	"syslog":            func() plugins.Config { return &inputs.Syslog{} },
+	"system":            func() plugins.Config { return &inputs.SystemStats{} },
+	"tail":              func() plugins.Config { return &inputs.Tail{} },
+	"temp":              func() plugins.Config { return &inputs.TempStats{} },
+	"varnish":           func() plugins.Config { return &inputs.VarnishStats{} },
+	"win_perf_counters": func() plugins.Config { return &inputs.WinPerfCountersStats{} },
+	"x509_cert":         func() plugins.Config { return &inputs.X509CertStats{} },
 }
 
 var availableOutputPlugins = map[string](func() plugins.Config){
 	"file":        func() plugins.Config { return &outputs.File{} },
+	"influxdb":    func() plugins.Config { return &outputs.InfluxDBOutput{} },
 	"influxdb_v2": func() plugins.Config { return &outputs.InfluxDBV2{} },
 }
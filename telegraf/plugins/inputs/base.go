@@ -1,9 +1,23 @@
 package inputs
 
-import "github.com/influxdata/influxdb/v2/telegraf/plugins"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb/v2/telegraf/plugins"
+)
 
 type baseInput int
 
 func (b baseInput) Type() plugins.Type {
 	return plugins.Input
 }
+
+// quoteJoin quotes each string and joins them into the body of a toml array.
+func quoteJoin(arr []string) string {
+	s := make([]string, len(arr))
+	for k, v := range arr {
+		s[k] = strconv.Quote(v)
+	}
+	return strings.Join(s, ", ")
+}
@@ -105,6 +105,11 @@ func TestEncodeTOML(t *testing.T) {
   data_format = "influx"
 `,
 				&Kernel{}: "[[inputs.kernel]]\n",
+				&KubeInventoryStats{}: `[[inputs.kube_inventory]]
+  ## URL for the Kubernetes API
+  ## exp: https://127.0.0.1:6443
+  url = ""
+`,
 				&Kubernetes{}: `[[inputs.kubernetes]]
   ## URL for the kubelet
   ## exp: http://1.1.1.1:10255
@@ -137,7 +142,31 @@ func TestEncodeTOML(t *testing.T) {
     ## Name of the outputted measurement name.
     measurement = "apache_access_log"
 `,
-				&MemStats{}:   "[[inputs.mem]]\n",
+				&MemStats{}: "[[inputs.mem]]\n",
+				&MQTTConsumerStats{}: `[[inputs.mqtt_consumer]]
+  ## MQTT broker URLs to be used.
+  ## exp: tcp://127.0.0.1:1883
+  servers = []
+  ## Topics that will be subscribed to.
+  topics = []
+  ## QoS policy for messages, can be 0, 1 or 2.
+  qos = 0
+`,
+				&MySQLStats{}: `[[inputs.mysql]]
+  ## specify servers via a url matching:
+  ##  [username[:password]@][protocol[(address)]]/[?tls=[true|false|skip-verify|custom]]
+  ##  exp: root:passwd@tcp(127.0.0.1:3306)/?tls=false
+  servers = []
+  ## Metric version controls the mapping from MySQL metrics into
+  ## InfluxDB points, can be either 1 or 2.
+  metric_version = 2
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_TABLE
+  gather_table_io_waits = false
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_INDEX_USAGE
+  gather_index_io_waits = false
+  ## gather thread state counts from INFORMATION_SCHEMA.PROCESSLIST
+  gather_process_list = false
+`,
 				&NetIOStats{}: "[[inputs.net]]\n",
 				&NetResponse{}: `[[inputs.net_response]]
   ## Protocol, must be "tcp" or "udp"
@@ -151,6 +180,10 @@ func TestEncodeTOML(t *testing.T) {
   # An array of Nginx stub_status URI to gather stats.
   # exp http://localhost/server_status
   urls = []
+`,
+				&PingStats{}: `[[inputs.ping]]
+  ## Hosts to send ping packets to.
+  urls = []
 `,
 				&Processes{}: "[[inputs.processes]]\n",
 				&Procstat{}: `[[inputs.procstat]]
@@ -175,6 +208,20 @@ func TestEncodeTOML(t *testing.T) {
 
   ## specify server password
   # password = ""
+`,
+				&SocketListenerStats{}: `[[inputs.socket_listener]]
+  ## URL to listen on
+  ## exp: tcp://:8094, udp://127.0.0.1:8094, unix:///tmp/telegraf.sock
+  service_address = ""
+
+  ## Maximum socket buffer size (in bytes when no unit specified).
+  # read_buffer_size = "64KiB"
+
+  ## Data format to consume.
+  ## Each data format has its own unique set of configuration options, read
+  ## more about them here:
+  ## https://github.com/influxdata/telegraf/blob/master/docs/DATA_FORMATS_INPUT.md
+  data_format = ""
 `,
 				&SwapStats{}: "[[inputs.swap]]\n",
 				&Syslog{}: `[[inputs.syslog]]
@@ -184,7 +231,18 @@ func TestEncodeTOML(t *testing.T) {
   ## If no port is specified, 6514 is used (RFC5425#section-4.1).
   server = ""
 `,
-				&SystemStats{}: "[[inputs.system]]\n",
+				&SystemStats{}:  "[[inputs.system]]\n",
+				&TempStats{}:    "[[inputs.temp]]\n",
+				&VarnishStats{}: "[[inputs.varnish]]\n",
+				&WinPerfCountersStats{}: `[[inputs.win_perf_counters]]
+  ## Each object is a performance counter object to gather,
+  ## Instances = ["*"] gathers all the instances of the object.
+`,
+				&X509CertStats{}: `[[inputs.x509_cert]]
+  ## List certificate sources
+  ## exp: "/etc/ssl/certs/ssl-cert-snakeoil.pem", "tcp://example.org:443"
+  sources = []
+`,
 				&Tail{}: `[[inputs.tail]]	
   ## files to tail.
   ## These accept standard unix glob matching rules, but with the addition of
@@ -275,6 +333,22 @@ func TestEncodeTOML(t *testing.T) {
   ## more about them here:
   ## https://github.com/influxdata/telegraf/blob/master/docs/DATA_FORMATS_INPUT.md
   data_format = "influx"
+`,
+				&KubeInventoryStats{
+					URL:             "https://127.0.0.1:6443",
+					BearerToken:     "/run/secrets/kubernetes.io/serviceaccount/token",
+					Namespace:       "monitoring",
+					ResponseTimeout: "5s",
+				}: `[[inputs.kube_inventory]]
+  ## URL for the Kubernetes API
+  ## exp: https://127.0.0.1:6443
+  url = "https://127.0.0.1:6443"
+  ## Path to the bearer token file for authorization.
+  bearer_token = "/run/secrets/kubernetes.io/serviceaccount/token"
+  ## Namespace to use, empty collects all namespaces.
+  namespace = "monitoring"
+  ## Timeout for the kubernetes api requests.
+  response_timeout = "5s"
 `,
 				&Kubernetes{URL: "http://1.1.1.1:10255"}: `[[inputs.kubernetes]]
   ## URL for the kubelet
@@ -312,6 +386,131 @@ func TestEncodeTOML(t *testing.T) {
     patterns = ["%{COMBINED_LOG_FORMAT}"]
     ## Name of the outputted measurement name.
     measurement = "apache_access_log"
+`,
+				&LogParserPlugin{
+					Files:          []string{"/var/log/myapp.log"},
+					Patterns:       []string{"%{MYAPP_LOG}", "%{COMMON_LOG_FORMAT}"},
+					Measurement:    "myapp_log",
+					CustomPatterns: "MYAPP_LOG %{TIMESTAMP_ISO8601:timestamp} %{WORD:level} %{GREEDYDATA:message}",
+				}: `[[inputs.logparser]]	
+  ## Log files to parse.
+  ## These accept standard unix glob matching rules, but with the addition of
+  ## ** as a "super asterisk". ie:
+  ##   /var/log/**.log     -> recursively find all .log files in /var/log
+  ##   /var/log/*/*.log    -> find all .log files with a parent dir in /var/log
+  ##   /var/log/apache.log -> only tail the apache log file
+  files = ["/var/log/myapp.log"]
+
+  ## Read files that currently exist from the beginning. Files that are created
+  ## while telegraf is running (and that match the "files" globs) will always
+  ## be read from the beginning.
+  from_beginning = false
+  ## Method used to watch for file updates.  Can be either "inotify" or "poll".
+  # watch_method = "inotify"
+  ## Parse logstash-style "grok" patterns:
+  [inputs.logparser.grok]
+    ## This is a list of patterns to check the given log file(s) for.
+    ## Note that adding patterns here increases processing time. The most
+    ## efficient configuration is to have one pattern per logparser.
+    ## Other common built-in patterns are:
+    ##   %{COMMON_LOG_FORMAT}   (plain apache & nginx access logs)
+    ##   %{COMBINED_LOG_FORMAT} (access logs + referrer & agent)
+    patterns = ["%{MYAPP_LOG}", "%{COMMON_LOG_FORMAT}"]
+    ## Name of the outputted measurement name.
+    measurement = "myapp_log"
+    ## Custom patterns can also be defined here.
+    custom_patterns = '''
+MYAPP_LOG %{TIMESTAMP_ISO8601:timestamp} %{WORD:level} %{GREEDYDATA:message}
+'''
+`,
+				&LogParserPlugin{
+					Files:          []string{"/var/log/myapp.log"},
+					CustomPatterns: "FOO '''\n[[outputs.http]]",
+				}: `[[inputs.logparser]]	
+  ## Log files to parse.
+  ## These accept standard unix glob matching rules, but with the addition of
+  ## ** as a "super asterisk". ie:
+  ##   /var/log/**.log     -> recursively find all .log files in /var/log
+  ##   /var/log/*/*.log    -> find all .log files with a parent dir in /var/log
+  ##   /var/log/apache.log -> only tail the apache log file
+  files = ["/var/log/myapp.log"]
+
+  ## Read files that currently exist from the beginning. Files that are created
+  ## while telegraf is running (and that match the "files" globs) will always
+  ## be read from the beginning.
+  from_beginning = false
+  ## Method used to watch for file updates.  Can be either "inotify" or "poll".
+  # watch_method = "inotify"
+  ## Parse logstash-style "grok" patterns:
+  [inputs.logparser.grok]
+    ## This is a list of patterns to check the given log file(s) for.
+    ## Note that adding patterns here increases processing time. The most
+    ## efficient configuration is to have one pattern per logparser.
+    ## Other common built-in patterns are:
+    ##   %{COMMON_LOG_FORMAT}   (plain apache & nginx access logs)
+    ##   %{COMBINED_LOG_FORMAT} (access logs + referrer & agent)
+    patterns = ["%{COMBINED_LOG_FORMAT}"]
+    ## Name of the outputted measurement name.
+    measurement = "apache_access_log"
+`,
+				&MQTTConsumerStats{
+					Servers:    []string{"tcp://127.0.0.1:1883"},
+					Topics:     []string{"telegraf/host01/cpu", "telegraf/+/mem"},
+					Username:   "telegraf",
+					Password:   "secret",
+					QoS:        1,
+					DataFormat: "json",
+				}: `[[inputs.mqtt_consumer]]
+  ## MQTT broker URLs to be used.
+  ## exp: tcp://127.0.0.1:1883
+  servers = ["tcp://127.0.0.1:1883"]
+  ## Topics that will be subscribed to.
+  topics = ["telegraf/host01/cpu", "telegraf/+/mem"]
+  ## QoS policy for messages, can be 0, 1 or 2.
+  qos = 1
+  username = "telegraf"
+  password = "secret"
+  data_format = "json"
+`,
+				&MySQLStats{
+					Servers:            []string{"root:passwd@tcp(127.0.0.1:3306)/?tls=false", "tcp(db2:3306)/"},
+					MetricVersion:      2,
+					GatherTableIOWaits: true,
+					GatherProcessList:  true,
+				}: `[[inputs.mysql]]
+  ## specify servers via a url matching:
+  ##  [username[:password]@][protocol[(address)]]/[?tls=[true|false|skip-verify|custom]]
+  ##  exp: root:passwd@tcp(127.0.0.1:3306)/?tls=false
+  servers = ["root:passwd@tcp(127.0.0.1:3306)/?tls=false", "tcp(db2:3306)/"]
+  ## Metric version controls the mapping from MySQL metrics into
+  ## InfluxDB points, can be either 1 or 2.
+  metric_version = 2
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_TABLE
+  gather_table_io_waits = true
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_INDEX_USAGE
+  gather_index_io_waits = false
+  ## gather thread state counts from INFORMATION_SCHEMA.PROCESSLIST
+  gather_process_list = true
+`,
+				&NetResponse{
+					Protocol: "tcp",
+					Address:  "github.com:22",
+					Timeout:  "2s",
+					Send:     "ssh",
+					Expect:   "SSH-2.0",
+				}: `[[inputs.net_response]]
+  ## Protocol, must be "tcp" or "udp"
+  ## NOTE: because the "udp" protocol does not respond to requests, it requires
+  ## a send/expect string pair (see below).
+  protocol = "tcp"
+  ## Server address (default localhost)
+  address = "github.com:22"
+  ## Set timeout
+  timeout = "2s"
+  ## string sent to the server
+  send = "ssh"
+  ## expected string in answer
+  expect = "SSH-2.0"
 `,
 				&Nginx{
 					URLs: []string{
@@ -322,6 +521,21 @@ func TestEncodeTOML(t *testing.T) {
   # An array of Nginx stub_status URI to gather stats.
   # exp http://localhost/server_status
   urls = ["http://localhost/server_status", "http://192.168.1.1/server_status"]
+`,
+				&PingStats{
+					URLs:         []string{"example.org", "192.168.1.1"},
+					Count:        3,
+					PingInterval: 0.5,
+					Method:       "native",
+				}: `[[inputs.ping]]
+  ## Hosts to send ping packets to.
+  urls = ["example.org", "192.168.1.1"]
+  ## Method used for sending pings, can be either "exec" or "native".
+  method = "native"
+  ## Number of ping packets to send per interval.
+  count = 3
+  ## Time to wait between sending ping packets in seconds.
+  ping_interval = 0.5
 `,
 				&Procstat{
 					Exe: "finder",
@@ -358,6 +572,24 @@ func TestEncodeTOML(t *testing.T) {
 
   ## specify server password
   password = "somepassword123"
+`,
+				&SocketListenerStats{
+					ServiceAddress: "tcp://:8094",
+					DataFormat:     "influx",
+					ReadBufferSize: "1MiB",
+				}: `[[inputs.socket_listener]]
+  ## URL to listen on
+  ## exp: tcp://:8094, udp://127.0.0.1:8094, unix:///tmp/telegraf.sock
+  service_address = "tcp://:8094"
+
+  ## Maximum socket buffer size (in bytes when no unit specified).
+  read_buffer_size = "1MiB"
+
+  ## Data format to consume.
+  ## Each data format has its own unique set of configuration options, read
+  ## more about them here:
+  ## https://github.com/influxdata/telegraf/blob/master/docs/DATA_FORMATS_INPUT.md
+  data_format = "influx"
 `,
 				&Syslog{
 					Address: "tcp://10.0.0.1:6514",
@@ -367,6 +599,81 @@ func TestEncodeTOML(t *testing.T) {
   ## If no host is specified, then localhost is used.
   ## If no port is specified, 6514 is used (RFC5425#section-4.1).
   server = "tcp://10.0.0.1:6514"
+`,
+				&Syslog{
+					Address:        "tcp://:6514",
+					SyslogStandard: "RFC5424",
+					Framing:        "octet-counting",
+				}: `[[inputs.syslog]]
+  ## Specify an ip or hostname with port - eg., tcp://localhost:6514, tcp://10.0.0.1:6514
+  ## Protocol, address and port to host the syslog receiver.
+  ## If no host is specified, then localhost is used.
+  ## If no port is specified, 6514 is used (RFC5425#section-4.1).
+  server = "tcp://:6514"
+  ## Framing technique used for messages transport, can be either "octet-counting" or "non-transparent".
+  framing = "octet-counting"
+  ## The syslog standard the messages follow, can be either "RFC5424" or "RFC3164".
+  syslog_standard = "RFC5424"
+`,
+				&Syslog{
+					Address: `tcp://"host"\:6514`,
+				}: `[[inputs.syslog]]
+  ## Specify an ip or hostname with port - eg., tcp://localhost:6514, tcp://10.0.0.1:6514
+  ## Protocol, address and port to host the syslog receiver.
+  ## If no host is specified, then localhost is used.
+  ## If no port is specified, 6514 is used (RFC5425#section-4.1).
+  server = "tcp://\"host\"\\:6514"
+`,
+				&VarnishStats{
+					Binary:       "/usr/bin/varnishstat",
+					Stats:        []string{"MAIN.cache_hit", "MAIN.cache_miss", "MAIN.uptime"},
+					InstanceName: "varnish01",
+				}: `[[inputs.varnish]]
+  ## The default location of the varnishstat binary can be overridden with:
+  binary = "/usr/bin/varnishstat"
+  ## Glob matching the stats fields to collect, exp: MAIN.*
+  stats = ["MAIN.cache_hit", "MAIN.cache_miss", "MAIN.uptime"]
+  ## Optional name for the varnish instance to query.
+  instance_name = "varnish01"
+`,
+				&X509CertStats{
+					Sources: []string{"/etc/ssl/certs/ssl-cert-snakeoil.pem", "tcp://example.org:443"},
+					Timeout: "5s",
+				}: `[[inputs.x509_cert]]
+  ## List certificate sources
+  ## exp: "/etc/ssl/certs/ssl-cert-snakeoil.pem", "tcp://example.org:443"
+  sources = ["/etc/ssl/certs/ssl-cert-snakeoil.pem", "tcp://example.org:443"]
+  ## Timeout for SSL connection
+  timeout = "5s"
+`,
+				&WinPerfCountersStats{
+					Objects: []WinPerfCounterObject{
+						{
+							ObjectName:  "Processor",
+							Counters:    []string{"% Idle Time", "% Processor Time"},
+							Instances:   []string{"*"},
+							Measurement: "win_cpu",
+						},
+						{
+							ObjectName:  "LogicalDisk",
+							Counters:    []string{"% Free Space"},
+							Instances:   []string{"C:", "D:"},
+							Measurement: "win_disk",
+						},
+					},
+				}: `[[inputs.win_perf_counters]]
+  ## Each object is a performance counter object to gather,
+  ## Instances = ["*"] gathers all the instances of the object.
+  [[inputs.win_perf_counters.object]]
+    ObjectName = "Processor"
+    Counters = ["% Idle Time", "% Processor Time"]
+    Instances = ["*"]
+    Measurement = "win_cpu"
+  [[inputs.win_perf_counters.object]]
+    ObjectName = "LogicalDisk"
+    Counters = ["% Free Space"]
+    Instances = ["C:", "D:"]
+    Measurement = "win_disk"
 `,
 				&Tail{
 					Files: []string{"/var/log/**.log", "/var/log/apache.log"},
@@ -393,6 +700,12 @@ func TestEncodeTOML(t *testing.T) {
   ## more about them here:
   ## https://github.com/influxdata/telegraf/blob/master/docs/DATA_FORMATS_INPUT.md
   data_format = "influx"
+`,
+				&MemStats{
+					ReportPercentages: true,
+				}: `[[inputs.mem]]
+  ## Only report the percentage fields.
+  fieldpass = ["*_percent"]
 `,
 			},
 		},
@@ -482,6 +795,24 @@ func TestDecodeTOML(t *testing.T) {
 			want:  &Kernel{},
 			input: &Kernel{},
 		},
+		{
+			name:    "kube_inventory empty",
+			want:    &KubeInventoryStats{},
+			wantErr: errors.New("bad url for kube_inventory input plugin"),
+			input:   &KubeInventoryStats{},
+		},
+		{
+			name: "kube_inventory",
+			want: &KubeInventoryStats{
+				URL:       "https://127.0.0.1:6443",
+				Namespace: "monitoring",
+			},
+			input: &KubeInventoryStats{},
+			data: map[string]interface{}{
+				"url":       "https://127.0.0.1:6443",
+				"namespace": "monitoring",
+			},
+		},
 		{
 			name:    "kubernetes empty",
 			want:    &Kubernetes{},
@@ -529,16 +860,104 @@ func TestDecodeTOML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "logparser grok",
+			want: &LogParserPlugin{
+				Files:          []string{"/var/log/myapp.log"},
+				Patterns:       []string{"%{MYAPP_LOG}"},
+				Measurement:    "myapp_log",
+				CustomPatterns: "MYAPP_LOG %{WORD:level} %{GREEDYDATA:message}",
+			},
+			input: &LogParserPlugin{},
+			data: map[string]interface{}{
+				"files": []interface{}{"/var/log/myapp.log"},
+				"grok": map[string]interface{}{
+					"patterns":        []interface{}{"%{MYAPP_LOG}"},
+					"measurement":     "myapp_log",
+					"custom_patterns": "MYAPP_LOG %{WORD:level} %{GREEDYDATA:message}",
+				},
+			},
+		},
 		{
 			name:  "mem",
 			want:  &MemStats{},
 			input: &MemStats{},
 		},
+		{
+			name: "mem report percentages",
+			want: &MemStats{
+				ReportPercentages: true,
+			},
+			input: &MemStats{},
+			data: map[string]interface{}{
+				"fieldpass": []interface{}{"*_percent"},
+			},
+		},
+		{
+			name:    "mqtt_consumer empty",
+			want:    &MQTTConsumerStats{},
+			wantErr: errors.New("bad servers for mqtt_consumer input plugin"),
+			input:   &MQTTConsumerStats{},
+		},
+		{
+			name: "mqtt_consumer",
+			want: &MQTTConsumerStats{
+				Servers:    []string{"tcp://127.0.0.1:1883"},
+				Topics:     []string{"telegraf/#"},
+				QoS:        2,
+				DataFormat: "json",
+			},
+			input: &MQTTConsumerStats{},
+			data: map[string]interface{}{
+				"servers":     []interface{}{"tcp://127.0.0.1:1883"},
+				"topics":      []interface{}{"telegraf/#"},
+				"qos":         int64(2),
+				"data_format": "json",
+			},
+		},
+		{
+			name:    "mysql empty",
+			want:    &MySQLStats{},
+			wantErr: errors.New("bad servers for mysql input plugin"),
+			input:   &MySQLStats{},
+		},
+		{
+			name: "mysql",
+			want: &MySQLStats{
+				Servers:            []string{"root:passwd@tcp(127.0.0.1:3306)/"},
+				MetricVersion:      2,
+				GatherIndexIOWaits: true,
+			},
+			input: &MySQLStats{},
+			data: map[string]interface{}{
+				"servers":               []interface{}{"root:passwd@tcp(127.0.0.1:3306)/"},
+				"metric_version":        int64(2),
+				"gather_index_io_waits": true,
+			},
+		},
 		{
 			name:  "net_response",
 			want:  &NetResponse{},
 			input: &NetResponse{},
 		},
+		{
+			name: "net_response udp",
+			want: &NetResponse{
+				Protocol: "udp",
+				Address:  "localhost:161",
+				Timeout:  "1s",
+				Send:     "ping",
+				Expect:   "pong",
+			},
+			input: &NetResponse{},
+			data: map[string]interface{}{
+				"protocol": "udp",
+				"address":  "localhost:161",
+				"timeout":  "1s",
+				"send":     "ping",
+				"expect":   "pong",
+			},
+		},
 		{
 			name:  "net",
 			want:  &NetIOStats{},
@@ -575,6 +994,40 @@ func TestDecodeTOML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "ping empty",
+			want:    &PingStats{},
+			wantErr: errors.New("bad urls for ping input plugin"),
+			input:   &PingStats{},
+		},
+		{
+			name: "ping",
+			want: &PingStats{
+				URLs:         []string{"example.org", "192.168.1.1"},
+				Count:        3,
+				PingInterval: 0.5,
+				Method:       "native",
+			},
+			input: &PingStats{},
+			data: map[string]interface{}{
+				"urls":          []interface{}{"example.org", "192.168.1.1"},
+				"count":         int64(3),
+				"ping_interval": 0.5,
+				"method":        "native",
+			},
+		},
+		{
+			name: "ping with integer interval",
+			want: &PingStats{
+				URLs:         []string{"example.org"},
+				PingInterval: 1,
+			},
+			input: &PingStats{},
+			data: map[string]interface{}{
+				"urls":          []interface{}{"example.org"},
+				"ping_interval": int64(1),
+			},
+		},
 		{
 			name:  "processes",
 			want:  &Processes{},
@@ -676,6 +1129,24 @@ func TestDecodeTOML(t *testing.T) {
 				"password": "pass1",
 			},
 		},
+		{
+			name:    "socket_listener empty",
+			want:    &SocketListenerStats{},
+			wantErr: errors.New("bad service_address for socket_listener input plugin"),
+			input:   &SocketListenerStats{},
+		},
+		{
+			name: "socket_listener",
+			want: &SocketListenerStats{
+				ServiceAddress: "udp://:8094",
+				DataFormat:     "influx",
+			},
+			input: &SocketListenerStats{},
+			data: map[string]interface{}{
+				"service_address": "udp://:8094",
+				"data_format":     "influx",
+			},
+		},
 		{
 			name:  "swap",
 			want:  &SwapStats{},
@@ -697,11 +1168,30 @@ func TestDecodeTOML(t *testing.T) {
 				"server": "http://1.1.1.1:10255",
 			},
 		},
+		{
+			name: "syslog rfc3164",
+			want: &Syslog{
+				Address:        "udp://:6514",
+				SyslogStandard: "RFC3164",
+				Framing:        "non-transparent",
+			},
+			input: &Syslog{},
+			data: map[string]interface{}{
+				"server":          "udp://:6514",
+				"syslog_standard": "RFC3164",
+				"framing":         "non-transparent",
+			},
+		},
 		{
 			name:  "system",
 			want:  &SystemStats{},
 			input: &SystemStats{},
 		},
+		{
+			name:  "temp",
+			want:  &TempStats{},
+			input: &TempStats{},
+		},
 		{
 			name:    "tail empty",
 			want:    &Tail{},
@@ -733,6 +1223,70 @@ func TestDecodeTOML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "varnish empty",
+			want:    &VarnishStats{},
+			wantErr: errors.New("bad binary for varnish input plugin"),
+			input:   &VarnishStats{},
+		},
+		{
+			name: "varnish",
+			want: &VarnishStats{
+				Stats: []string{"MAIN.*"},
+			},
+			input: &VarnishStats{},
+			data: map[string]interface{}{
+				"stats": []interface{}{"MAIN.*"},
+			},
+		},
+		{
+			name:    "x509_cert empty",
+			want:    &X509CertStats{},
+			wantErr: errors.New("bad sources for x509_cert input plugin"),
+			input:   &X509CertStats{},
+		},
+		{
+			name: "x509_cert",
+			want: &X509CertStats{
+				Sources: []string{"https://example.org:443", "/etc/ssl/certs/ca.pem"},
+				Timeout: "5s",
+			},
+			input: &X509CertStats{},
+			data: map[string]interface{}{
+				"sources": []interface{}{"https://example.org:443", "/etc/ssl/certs/ca.pem"},
+				"timeout": "5s",
+			},
+		},
+		{
+			name:    "win_perf_counters empty",
+			want:    &WinPerfCountersStats{},
+			wantErr: errors.New("bad object for win_perf_counters input plugin"),
+			input:   &WinPerfCountersStats{},
+		},
+		{
+			name: "win_perf_counters",
+			want: &WinPerfCountersStats{
+				Objects: []WinPerfCounterObject{
+					{
+						ObjectName:  "Processor",
+						Counters:    []string{"% Processor Time"},
+						Instances:   []string{"*"},
+						Measurement: "win_cpu",
+					},
+				},
+			},
+			input: &WinPerfCountersStats{},
+			data: map[string]interface{}{
+				"object": []map[string]interface{}{
+					{
+						"ObjectName":  "Processor",
+						"Counters":    []interface{}{"% Processor Time"},
+						"Instances":   []interface{}{"*"},
+						"Measurement": "win_cpu",
+					},
+				},
+			},
+		},
 	}
 	for _, c := range cases {
 		err := c.input.UnmarshalTOML(c.data)
@@ -747,3 +1301,232 @@ func TestDecodeTOML(t *testing.T) {
 		}
 	}
 }
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   interface{ Validate() error }
+		wantErr error
+	}{
+		{
+			name: "ping",
+			input: &PingStats{
+				URLs:   []string{"example.org", "192.168.1.1"},
+				Count:  3,
+				Method: "native",
+			},
+		},
+		{
+			name:    "ping without urls",
+			input:   &PingStats{Count: 1},
+			wantErr: errors.New("at least one url is required for ping input plugin"),
+		},
+		{
+			name:    "ping without count",
+			input:   &PingStats{URLs: []string{"example.org"}},
+			wantErr: errors.New("count must be positive for ping input plugin"),
+		},
+		{
+			name:    "ping negative count",
+			input:   &PingStats{URLs: []string{"example.org"}, Count: -1},
+			wantErr: errors.New("count must be positive for ping input plugin"),
+		},
+		{
+			name: "ping invalid method",
+			input: &PingStats{
+				URLs:   []string{"example.org"},
+				Count:  1,
+				Method: "icmp",
+			},
+			wantErr: errors.New(`invalid method "icmp" for ping input plugin`),
+		},
+		{
+			name:  "kube_inventory",
+			input: &KubeInventoryStats{URL: "https://127.0.0.1:6443", Namespace: "monitoring"},
+		},
+		{
+			name:    "kube_inventory without url",
+			input:   &KubeInventoryStats{Namespace: "monitoring"},
+			wantErr: errors.New("url is required for kube_inventory input plugin"),
+		},
+		{
+			name: "logparser",
+			input: &LogParserPlugin{
+				Files:    []string{"/var/log/apache.log"},
+				Patterns: []string{"%{COMBINED_LOG_FORMAT}"},
+			},
+		},
+		{
+			name:    "logparser without files",
+			input:   &LogParserPlugin{Patterns: []string{"%{COMBINED_LOG_FORMAT}"}},
+			wantErr: errors.New("at least one file is required for logparser input plugin"),
+		},
+		{
+			name:  "logparser default pattern",
+			input: &LogParserPlugin{Files: []string{"/var/log/apache.log"}},
+		},
+		{
+			name: "logparser custom patterns with triple quotes",
+			input: &LogParserPlugin{
+				Files:          []string{"/var/log/apache.log"},
+				CustomPatterns: "FOO '''",
+			},
+			wantErr: errors.New("custom_patterns can't contain ''' for logparser input plugin"),
+		},
+		{
+			name: "mqtt_consumer",
+			input: &MQTTConsumerStats{
+				Servers: []string{"tcp://127.0.0.1:1883"},
+				Topics:  []string{"telegraf/#"},
+				QoS:     2,
+			},
+		},
+		{
+			name:    "mqtt_consumer without topics",
+			input:   &MQTTConsumerStats{Servers: []string{"tcp://127.0.0.1:1883"}},
+			wantErr: errors.New("at least one topic is required for mqtt_consumer input plugin"),
+		},
+		{
+			name: "mqtt_consumer qos out of range",
+			input: &MQTTConsumerStats{
+				Servers: []string{"tcp://127.0.0.1:1883"},
+				Topics:  []string{"telegraf/#"},
+				QoS:     3,
+			},
+			wantErr: errors.New("qos 3 is out of range 0-2 for mqtt_consumer input plugin"),
+		},
+		{
+			name: "mysql",
+			input: &MySQLStats{
+				Servers:       []string{"root:passwd@tcp(127.0.0.1:3306)/"},
+				MetricVersion: 1,
+			},
+		},
+		{
+			name:  "mysql default metric_version",
+			input: &MySQLStats{Servers: []string{"root:passwd@tcp(127.0.0.1:3306)/"}},
+		},
+		{
+			name:    "mysql without servers",
+			input:   &MySQLStats{MetricVersion: 2},
+			wantErr: errors.New("at least one server is required for mysql input plugin"),
+		},
+		{
+			name: "mysql invalid metric_version",
+			input: &MySQLStats{
+				Servers:       []string{"root:passwd@tcp(127.0.0.1:3306)/"},
+				MetricVersion: 3,
+			},
+			wantErr: errors.New("invalid metric_version 3 for mysql input plugin"),
+		},
+		{
+			name: "net_response",
+			input: &NetResponse{
+				Protocol: "tcp",
+				Address:  "github.com:22",
+				Timeout:  "2s",
+				Send:     "ssh",
+				Expect:   "SSH-2.0",
+			},
+		},
+		{
+			name:  "net_response defaults",
+			input: &NetResponse{},
+		},
+		{
+			name:    "net_response invalid protocol",
+			input:   &NetResponse{Protocol: "icmp", Address: "localhost:80"},
+			wantErr: errors.New(`invalid protocol "icmp" for net_response input plugin, must be tcp or udp`),
+		},
+		{
+			name:    "net_response invalid address",
+			input:   &NetResponse{Protocol: "tcp", Address: "localhost"},
+			wantErr: errors.New(`invalid address "localhost" for net_response input plugin, must be host:port`),
+		},
+		{
+			name:    "net_response udp without expect",
+			input:   &NetResponse{Protocol: "udp", Address: "localhost:161", Send: "ping"},
+			wantErr: errors.New("send and expect are required for udp protocol of net_response input plugin"),
+		},
+		{
+			name:  "socket_listener",
+			input: &SocketListenerStats{ServiceAddress: "tcp://:8094", DataFormat: "influx"},
+		},
+		{
+			name:    "socket_listener invalid scheme",
+			input:   &SocketListenerStats{ServiceAddress: "http://:8094"},
+			wantErr: errors.New(`invalid service_address "http://:8094" for socket_listener input plugin, scheme must be one of tcp, udp, unix or unixgram`),
+		},
+		{
+			name: "syslog",
+			input: &Syslog{
+				Address:        "tcp://:6514",
+				SyslogStandard: "RFC5424",
+				Framing:        "octet-counting",
+			},
+		},
+		{
+			name:    "syslog invalid standard",
+			input:   &Syslog{Address: "tcp://:6514", SyslogStandard: "RFC5425"},
+			wantErr: errors.New(`invalid syslog_standard "RFC5425" for syslog input plugin, must be RFC5424 or RFC3164`),
+		},
+		{
+			name:    "syslog invalid framing",
+			input:   &Syslog{Address: "tcp://:6514", Framing: "newline"},
+			wantErr: errors.New(`invalid framing "newline" for syslog input plugin, must be octet-counting or non-transparent`),
+		},
+		{
+			name:  "varnish",
+			input: &VarnishStats{},
+		},
+		{
+			name:  "varnish with binary",
+			input: &VarnishStats{Binary: "/usr/bin/varnishstat", Stats: []string{"MAIN.*"}},
+		},
+		{
+			name:    "varnish relative binary",
+			input:   &VarnishStats{Binary: "bin/varnishstat"},
+			wantErr: errors.New(`binary "bin/varnishstat" must be an absolute path for varnish input plugin`),
+		},
+		{
+			name:  "x509_cert",
+			input: &X509CertStats{Sources: []string{"https://example.org:443", "/etc/ssl/certs/ca.pem"}},
+		},
+		{
+			name:    "x509_cert without sources",
+			input:   &X509CertStats{Timeout: "5s"},
+			wantErr: errors.New("at least one source is required for x509_cert input plugin"),
+		},
+		{
+			name: "win_perf_counters",
+			input: &WinPerfCountersStats{
+				Objects: []WinPerfCounterObject{
+					{ObjectName: "Processor", Counters: []string{"% Processor Time"}},
+				},
+			},
+		},
+		{
+			name:    "win_perf_counters without objects",
+			input:   &WinPerfCountersStats{},
+			wantErr: errors.New("at least one object is required for win_perf_counters input plugin"),
+		},
+		{
+			name: "win_perf_counters without counters",
+			input: &WinPerfCountersStats{
+				Objects: []WinPerfCounterObject{
+					{ObjectName: "Processor"},
+				},
+			},
+			wantErr: errors.New(`at least one counter is required for object "Processor" of win_perf_counters input plugin`),
+		},
+	}
+	for _, c := range cases {
+		err := c.input.Validate()
+		if c.wantErr != nil && (err == nil || err.Error() != c.wantErr.Error()) {
+			t.Fatalf("%s failed want err %s, got %v", c.name, c.wantErr.Error(), err)
+		}
+		if c.wantErr == nil && err != nil {
+			t.Fatalf("%s failed want err nil, got %v", c.name, err)
+		}
+	}
+}
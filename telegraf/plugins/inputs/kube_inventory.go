@@ -0,0 +1,61 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// KubeInventoryStats is based on telegraf KubernetesInventory plugin.
+type KubeInventoryStats struct {
+	baseInput
+	URL             string `json:"url"`
+	BearerToken     string `json:"bearer_token"`
+	Namespace       string `json:"namespace"`
+	ResponseTimeout string `json:"response_timeout"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (k *KubeInventoryStats) PluginName() string {
+	return "kube_inventory"
+}
+
+// TOML encodes to toml string.
+func (k *KubeInventoryStats) TOML() string {
+	var opts string
+	if k.BearerToken != "" {
+		opts += fmt.Sprintf("  ## Path to the bearer token file for authorization.\n  bearer_token = %s\n", strconv.Quote(k.BearerToken))
+	}
+	if k.Namespace != "" {
+		opts += fmt.Sprintf("  ## Namespace to use, empty collects all namespaces.\n  namespace = %s\n", strconv.Quote(k.Namespace))
+	}
+	if k.ResponseTimeout != "" {
+		opts += fmt.Sprintf("  ## Timeout for the kubernetes api requests.\n  response_timeout = %s\n", strconv.Quote(k.ResponseTimeout))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## URL for the Kubernetes API
+  ## exp: https://127.0.0.1:6443
+  url = %s
+%s`, k.PluginName(), strconv.Quote(k.URL), opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (k *KubeInventoryStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad url for kube_inventory input plugin")
+	}
+	k.URL, _ = dataOK["url"].(string)
+	k.BearerToken, _ = dataOK["bearer_token"].(string)
+	k.Namespace, _ = dataOK["namespace"].(string)
+	k.ResponseTimeout, _ = dataOK["response_timeout"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (k *KubeInventoryStats) Validate() error {
+	if k.URL == "" {
+		return errors.New("url is required for kube_inventory input plugin")
+	}
+	return nil
+}
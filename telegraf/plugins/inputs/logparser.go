@@ -11,6 +11,11 @@ import (
 type LogParserPlugin struct {
 	baseInput
 	Files []string `json:"files"`
+	// Patterns, Measurement and CustomPatterns are rendered
+	// in the [inputs.logparser.grok] sub-table.
+	Patterns       []string `json:"patterns,omitempty"`
+	Measurement    string   `json:"measurement,omitempty"`
+	CustomPatterns string   `json:"custom_patterns,omitempty"`
 }
 
 // PluginName is based on telegraf plugin name.
@@ -24,6 +29,19 @@ func (l *LogParserPlugin) TOML() string {
 	for k, v := range l.Files {
 		s[k] = strconv.Quote(v)
 	}
+	patterns := `"%{COMBINED_LOG_FORMAT}"`
+	if len(l.Patterns) > 0 {
+		patterns = quoteJoin(l.Patterns)
+	}
+	measurement := "apache_access_log"
+	if l.Measurement != "" {
+		measurement = l.Measurement
+	}
+	var opts string
+	// A ''' would close the literal string, so such patterns are never rendered.
+	if l.CustomPatterns != "" && !strings.Contains(l.CustomPatterns, "'''") {
+		opts = fmt.Sprintf("    ## Custom patterns can also be defined here.\n    custom_patterns = '''\n%s\n'''\n", l.CustomPatterns)
+	}
 	return fmt.Sprintf(`[[inputs.%s]]	
   ## Log files to parse.
   ## These accept standard unix glob matching rules, but with the addition of
@@ -47,10 +65,10 @@ func (l *LogParserPlugin) TOML() string {
     ## Other common built-in patterns are:
     ##   %%{COMMON_LOG_FORMAT}   (plain apache & nginx access logs)
     ##   %%{COMBINED_LOG_FORMAT} (access logs + referrer & agent)
-    patterns = ["%%{COMBINED_LOG_FORMAT}"]
+    patterns = [%s]
     ## Name of the outputted measurement name.
-    measurement = "apache_access_log"
-`, l.PluginName(), strings.Join(s, ", "))
+    measurement = %s
+%s`, l.PluginName(), strings.Join(s, ", "), patterns, strconv.Quote(measurement), opts)
 }
 
 // UnmarshalTOML decodes the parsed data to the object
@@ -66,5 +84,26 @@ func (l *LogParserPlugin) UnmarshalTOML(data interface{}) error {
 	for _, fi := range files {
 		l.Files = append(l.Files, fi.(string))
 	}
+	grok, ok := dataOK["grok"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	patterns, _ := grok["patterns"].([]interface{})
+	for _, p := range patterns {
+		l.Patterns = append(l.Patterns, p.(string))
+	}
+	l.Measurement, _ = grok["measurement"].(string)
+	l.CustomPatterns, _ = grok["custom_patterns"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (l *LogParserPlugin) Validate() error {
+	if len(l.Files) == 0 {
+		return errors.New("at least one file is required for logparser input plugin")
+	}
+	if strings.Contains(l.CustomPatterns, "'''") {
+		return errors.New("custom_patterns can't contain ''' for logparser input plugin")
+	}
 	return nil
 }
@@ -4,9 +4,13 @@ import (
 	"fmt"
 )
 
+const memPercentFieldPass = "*_percent"
+
 // MemStats is based on telegraf MemStats.
 type MemStats struct {
 	baseInput
+	// ReportPercentages restricts the reported fields to the *_percent ones.
+	ReportPercentages bool `json:"report_percentages,omitempty"`
 }
 
 // PluginName is based on telegraf plugin name.
@@ -16,11 +20,23 @@ func (m *MemStats) PluginName() string {
 
 // TOML encodes to toml string
 func (m *MemStats) TOML() string {
+	if m.ReportPercentages {
+		return fmt.Sprintf(`[[inputs.%s]]
+  ## Only report the percentage fields.
+  fieldpass = ["%s"]
+`, m.PluginName(), memPercentFieldPass)
+	}
 	return fmt.Sprintf(`[[inputs.%s]]
 `, m.PluginName())
 }
 
 // UnmarshalTOML decodes the parsed data to the object
 func (m *MemStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	fieldpass, _ := dataOK["fieldpass"].([]interface{})
+	m.ReportPercentages = len(fieldpass) == 1 && fieldpass[0] == memPercentFieldPass
 	return nil
 }
@@ -0,0 +1,89 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// MQTTConsumerStats is based on telegraf MQTTConsumer plugin.
+type MQTTConsumerStats struct {
+	baseInput
+	Servers    []string `json:"servers"`
+	Topics     []string `json:"topics"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	QoS        int      `json:"qos"`
+	DataFormat string   `json:"data_format"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (m *MQTTConsumerStats) PluginName() string {
+	return "mqtt_consumer"
+}
+
+// TOML encodes to toml string
+func (m *MQTTConsumerStats) TOML() string {
+	var opts string
+	if m.Username != "" {
+		opts += fmt.Sprintf("  username = %s\n", strconv.Quote(m.Username))
+	}
+	if m.Password != "" {
+		opts += fmt.Sprintf("  password = %s\n", strconv.Quote(m.Password))
+	}
+	if m.DataFormat != "" {
+		opts += fmt.Sprintf("  data_format = %s\n", strconv.Quote(m.DataFormat))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## MQTT broker URLs to be used.
+  ## exp: tcp://127.0.0.1:1883
+  servers = [%s]
+  ## Topics that will be subscribed to.
+  topics = [%s]
+  ## QoS policy for messages, can be 0, 1 or 2.
+  qos = %d
+%s`, m.PluginName(), quoteJoin(m.Servers), quoteJoin(m.Topics), m.QoS, opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (m *MQTTConsumerStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad servers for mqtt_consumer input plugin")
+	}
+	servers, ok := dataOK["servers"].([]interface{})
+	if !ok {
+		return errors.New("servers is not an array for mqtt_consumer input plugin")
+	}
+	for _, server := range servers {
+		m.Servers = append(m.Servers, server.(string))
+	}
+	topics, ok := dataOK["topics"].([]interface{})
+	if !ok {
+		return errors.New("topics is not an array for mqtt_consumer input plugin")
+	}
+	for _, topic := range topics {
+		m.Topics = append(m.Topics, topic.(string))
+	}
+	if qos, ok := dataOK["qos"].(int64); ok {
+		m.QoS = int(qos)
+	}
+	m.Username, _ = dataOK["username"].(string)
+	m.Password, _ = dataOK["password"].(string)
+	m.DataFormat, _ = dataOK["data_format"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (m *MQTTConsumerStats) Validate() error {
+	if len(m.Servers) == 0 {
+		return errors.New("at least one server is required for mqtt_consumer input plugin")
+	}
+	if len(m.Topics) == 0 {
+		return errors.New("at least one topic is required for mqtt_consumer input plugin")
+	}
+	if m.QoS < 0 || m.QoS > 2 {
+		return fmt.Errorf("qos %d is out of range 0-2 for mqtt_consumer input plugin", m.QoS)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MySQLStats is based on telegraf Mysql plugin.
+type MySQLStats struct {
+	baseInput
+	Servers            []string `json:"servers"`
+	MetricVersion      int      `json:"metric_version"`
+	GatherTableIOWaits bool     `json:"gather_table_io_waits"`
+	GatherIndexIOWaits bool     `json:"gather_index_io_waits"`
+	GatherProcessList  bool     `json:"gather_process_list"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (m *MySQLStats) PluginName() string {
+	return "mysql"
+}
+
+// metricVersion returns the metric version, defaulting to 2 when not set.
+func (m *MySQLStats) metricVersion() int {
+	if m.MetricVersion == 0 {
+		return 2
+	}
+	return m.MetricVersion
+}
+
+// TOML encodes to toml string
+func (m *MySQLStats) TOML() string {
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## specify servers via a url matching:
+  ##  [username[:password]@][protocol[(address)]]/[?tls=[true|false|skip-verify|custom]]
+  ##  exp: root:passwd@tcp(127.0.0.1:3306)/?tls=false
+  servers = [%s]
+  ## Metric version controls the mapping from MySQL metrics into
+  ## InfluxDB points, can be either 1 or 2.
+  metric_version = %d
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_TABLE
+  gather_table_io_waits = %t
+  ## gather metrics from PERFORMANCE_SCHEMA.TABLE_IO_WAITS_SUMMARY_BY_INDEX_USAGE
+  gather_index_io_waits = %t
+  ## gather thread state counts from INFORMATION_SCHEMA.PROCESSLIST
+  gather_process_list = %t
+`, m.PluginName(), quoteJoin(m.Servers), m.metricVersion(), m.GatherTableIOWaits, m.GatherIndexIOWaits, m.GatherProcessList)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (m *MySQLStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad servers for mysql input plugin")
+	}
+	servers, ok := dataOK["servers"].([]interface{})
+	if !ok {
+		return errors.New("servers is not an array for mysql input plugin")
+	}
+	for _, server := range servers {
+		m.Servers = append(m.Servers, server.(string))
+	}
+	if version, ok := dataOK["metric_version"].(int64); ok {
+		m.MetricVersion = int(version)
+	}
+	m.GatherTableIOWaits, _ = dataOK["gather_table_io_waits"].(bool)
+	m.GatherIndexIOWaits, _ = dataOK["gather_index_io_waits"].(bool)
+	m.GatherProcessList, _ = dataOK["gather_process_list"].(bool)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (m *MySQLStats) Validate() error {
+	if len(m.Servers) == 0 {
+		return errors.New("at least one server is required for mysql input plugin")
+	}
+	if v := m.metricVersion(); v != 1 && v != 2 {
+		return fmt.Errorf("invalid metric_version %d for mysql input plugin", m.MetricVersion)
+	}
+	return nil
+}
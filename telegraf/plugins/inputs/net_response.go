@@ -1,12 +1,21 @@
 package inputs
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"time"
 )
 
 // NetResponse is based on telegraf NetResponse.
 type NetResponse struct {
 	baseInput
+	Protocol string `json:"protocol"`
+	Address  string `json:"address"`
+	Timeout  string `json:"timeout"`
+	Send     string `json:"send"`
+	Expect   string `json:"expect"`
 }
 
 // PluginName is based on telegraf plugin name.
@@ -14,19 +23,72 @@ func (n *NetResponse) PluginName() string {
 	return "net_response"
 }
 
+// endpoint returns the protocol and address, falling back to the defaults
+// rendered when they are not set.
+func (n *NetResponse) endpoint() (protocol, address string) {
+	protocol, address = n.Protocol, n.Address
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	if address == "" {
+		address = "localhost:80"
+	}
+	return protocol, address
+}
+
 // TOML encodes to toml string
 func (n *NetResponse) TOML() string {
+	protocol, address := n.endpoint()
+	var opts string
+	if n.Timeout != "" {
+		opts += fmt.Sprintf("  ## Set timeout\n  timeout = %s\n", strconv.Quote(n.Timeout))
+	}
+	if n.Send != "" {
+		opts += fmt.Sprintf("  ## string sent to the server\n  send = %s\n", strconv.Quote(n.Send))
+	}
+	if n.Expect != "" {
+		opts += fmt.Sprintf("  ## expected string in answer\n  expect = %s\n", strconv.Quote(n.Expect))
+	}
 	return fmt.Sprintf(`[[inputs.%s]]
   ## Protocol, must be "tcp" or "udp"
   ## NOTE: because the "udp" protocol does not respond to requests, it requires
   ## a send/expect string pair (see below).
-  protocol = "tcp"
+  protocol = %s
   ## Server address (default localhost)
-  address = "localhost:80"
-`, n.PluginName())
+  address = %s
+%s`, n.PluginName(), strconv.Quote(protocol), strconv.Quote(address), opts)
 }
 
 // UnmarshalTOML decodes the parsed data to the object
 func (n *NetResponse) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	n.Protocol, _ = dataOK["protocol"].(string)
+	n.Address, _ = dataOK["address"].(string)
+	n.Timeout, _ = dataOK["timeout"].(string)
+	n.Send, _ = dataOK["send"].(string)
+	n.Expect, _ = dataOK["expect"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (n *NetResponse) Validate() error {
+	protocol, address := n.endpoint()
+	if protocol != "tcp" && protocol != "udp" {
+		return fmt.Errorf("invalid protocol %q for net_response input plugin, must be tcp or udp", protocol)
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return fmt.Errorf("invalid address %q for net_response input plugin, must be host:port", address)
+	}
+	if n.Timeout != "" {
+		if _, err := time.ParseDuration(n.Timeout); err != nil {
+			return fmt.Errorf("invalid timeout %q for net_response input plugin", n.Timeout)
+		}
+	}
+	if protocol == "udp" && (n.Send == "" || n.Expect == "") {
+		return errors.New("send and expect are required for udp protocol of net_response input plugin")
+	}
 	return nil
 }
@@ -0,0 +1,90 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var goodPingMethod = map[string]bool{
+	"exec":   true,
+	"native": true,
+}
+
+// PingStats is based on telegraf Ping plugin.
+// A zero PingInterval leaves the telegraf default in place.
+type PingStats struct {
+	baseInput
+	URLs         []string `json:"urls"`
+	Count        int      `json:"count"`
+	PingInterval float64  `json:"ping_interval"`
+	Method       string   `json:"method"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (p *PingStats) PluginName() string {
+	return "ping"
+}
+
+// TOML encodes to toml string
+func (p *PingStats) TOML() string {
+	var opts string
+	if p.Method != "" {
+		opts += fmt.Sprintf("  ## Method used for sending pings, can be either \"exec\" or \"native\".\n  method = %s\n", strconv.Quote(p.Method))
+	}
+	if p.Count != 0 {
+		opts += fmt.Sprintf("  ## Number of ping packets to send per interval.\n  count = %d\n", p.Count)
+	}
+	if p.PingInterval != 0 {
+		interval := strconv.FormatFloat(p.PingInterval, 'f', -1, 64)
+		if !strings.Contains(interval, ".") {
+			interval += ".0"
+		}
+		opts += fmt.Sprintf("  ## Time to wait between sending ping packets in seconds.\n  ping_interval = %s\n", interval)
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## Hosts to send ping packets to.
+  urls = [%s]
+%s`, p.PluginName(), quoteJoin(p.URLs), opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (p *PingStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad urls for ping input plugin")
+	}
+	urls, ok := dataOK["urls"].([]interface{})
+	if !ok {
+		return errors.New("urls is not an array for ping input plugin")
+	}
+	for _, url := range urls {
+		p.URLs = append(p.URLs, url.(string))
+	}
+	if count, ok := dataOK["count"].(int64); ok {
+		p.Count = int(count)
+	}
+	switch interval := dataOK["ping_interval"].(type) {
+	case float64:
+		p.PingInterval = interval
+	case int64:
+		p.PingInterval = float64(interval)
+	}
+	p.Method, _ = dataOK["method"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (p *PingStats) Validate() error {
+	if len(p.URLs) == 0 {
+		return errors.New("at least one url is required for ping input plugin")
+	}
+	if p.Count <= 0 {
+		return errors.New("count must be positive for ping input plugin")
+	}
+	if p.Method != "" && !goodPingMethod[p.Method] {
+		return fmt.Errorf("invalid method %q for ping input plugin", p.Method)
+	}
+	return nil
+}
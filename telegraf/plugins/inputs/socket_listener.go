@@ -0,0 +1,71 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var goodSocketListenerScheme = map[string]bool{
+	"tcp":      true,
+	"udp":      true,
+	"unix":     true,
+	"unixgram": true,
+}
+
+// SocketListenerStats is based on telegraf SocketListener plugin.
+type SocketListenerStats struct {
+	baseInput
+	ServiceAddress string `json:"service_address"`
+	DataFormat     string `json:"data_format"`
+	ReadBufferSize string `json:"read_buffer_size"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (s *SocketListenerStats) PluginName() string {
+	return "socket_listener"
+}
+
+// TOML encodes to toml string
+func (s *SocketListenerStats) TOML() string {
+	readBufferSize := `  # read_buffer_size = "64KiB"`
+	if s.ReadBufferSize != "" {
+		readBufferSize = fmt.Sprintf("  read_buffer_size = %s", strconv.Quote(s.ReadBufferSize))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## URL to listen on
+  ## exp: tcp://:8094, udp://127.0.0.1:8094, unix:///tmp/telegraf.sock
+  service_address = %s
+
+  ## Maximum socket buffer size (in bytes when no unit specified).
+%s
+
+  ## Data format to consume.
+  ## Each data format has its own unique set of configuration options, read
+  ## more about them here:
+  ## https://github.com/influxdata/telegraf/blob/master/docs/DATA_FORMATS_INPUT.md
+  data_format = %s
+`, s.PluginName(), strconv.Quote(s.ServiceAddress), readBufferSize, strconv.Quote(s.DataFormat))
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (s *SocketListenerStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad service_address for socket_listener input plugin")
+	}
+	s.ServiceAddress, _ = dataOK["service_address"].(string)
+	s.DataFormat, _ = dataOK["data_format"].(string)
+	s.ReadBufferSize, _ = dataOK["read_buffer_size"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (s *SocketListenerStats) Validate() error {
+	parts := strings.SplitN(s.ServiceAddress, "://", 2)
+	if len(parts) != 2 || !goodSocketListenerScheme[parts[0]] {
+		return fmt.Errorf("invalid service_address %q for socket_listener input plugin, scheme must be one of tcp, udp, unix or unixgram", s.ServiceAddress)
+	}
+	return nil
+}
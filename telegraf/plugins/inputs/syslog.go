@@ -3,12 +3,25 @@ package inputs
 import (
 	"errors"
 	"fmt"
+	"strconv"
 )
 
+var goodSyslogStandard = map[string]bool{
+	"RFC5424": true,
+	"RFC3164": true,
+}
+
+var goodSyslogFraming = map[string]bool{
+	"octet-counting":  true,
+	"non-transparent": true,
+}
+
 // Syslog is based on telegraf Syslog plugin.
 type Syslog struct {
 	baseInput
-	Address string `json:"server"`
+	Address        string `json:"server"`
+	SyslogStandard string `json:"syslog_standard,omitempty"`
+	Framing        string `json:"framing,omitempty"`
 }
 
 // PluginName is based on telegraf plugin name.
@@ -18,13 +31,20 @@ func (s *Syslog) PluginName() string {
 
 // TOML encodes to toml string
 func (s *Syslog) TOML() string {
+	var opts string
+	if s.Framing != "" {
+		opts += fmt.Sprintf("  ## Framing technique used for messages transport, can be either \"octet-counting\" or \"non-transparent\".\n  framing = %s\n", strconv.Quote(s.Framing))
+	}
+	if s.SyslogStandard != "" {
+		opts += fmt.Sprintf("  ## The syslog standard the messages follow, can be either \"RFC5424\" or \"RFC3164\".\n  syslog_standard = %s\n", strconv.Quote(s.SyslogStandard))
+	}
 	return fmt.Sprintf(`[[inputs.%s]]
   ## Specify an ip or hostname with port - eg., tcp://localhost:6514, tcp://10.0.0.1:6514
   ## Protocol, address and port to host the syslog receiver.
   ## If no host is specified, then localhost is used.
   ## If no port is specified, 6514 is used (RFC5425#section-4.1).
-  server = "%s"
-`, s.PluginName(), s.Address)
+  server = %s
+%s`, s.PluginName(), strconv.Quote(s.Address), opts)
 }
 
 // UnmarshalTOML decodes the parsed data to the object
@@ -34,5 +54,18 @@ func (s *Syslog) UnmarshalTOML(data interface{}) error {
 		return errors.New("bad server for syslog input plugin")
 	}
 	s.Address, _ = dataOK["server"].(string)
+	s.SyslogStandard, _ = dataOK["syslog_standard"].(string)
+	s.Framing, _ = dataOK["framing"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (s *Syslog) Validate() error {
+	if s.SyslogStandard != "" && !goodSyslogStandard[s.SyslogStandard] {
+		return fmt.Errorf("invalid syslog_standard %q for syslog input plugin, must be RFC5424 or RFC3164", s.SyslogStandard)
+	}
+	if s.Framing != "" && !goodSyslogFraming[s.Framing] {
+		return fmt.Errorf("invalid framing %q for syslog input plugin, must be octet-counting or non-transparent", s.Framing)
+	}
 	return nil
 }
@@ -0,0 +1,26 @@
+package inputs
+
+import (
+	"fmt"
+)
+
+// TempStats is based on telegraf Temp plugin.
+type TempStats struct {
+	baseInput
+}
+
+// PluginName is based on telegraf plugin name.
+func (t *TempStats) PluginName() string {
+	return "temp"
+}
+
+// TOML encodes to toml string
+func (t *TempStats) TOML() string {
+	return fmt.Sprintf(`[[inputs.%s]]
+`, t.PluginName())
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (t *TempStats) UnmarshalTOML(data interface{}) error {
+	return nil
+}
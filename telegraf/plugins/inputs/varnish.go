@@ -0,0 +1,60 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+)
+
+// VarnishStats is based on telegraf Varnish plugin.
+type VarnishStats struct {
+	baseInput
+	Binary       string   `json:"binary"`
+	Stats        []string `json:"stats"`
+	InstanceName string   `json:"instance_name"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (v *VarnishStats) PluginName() string {
+	return "varnish"
+}
+
+// TOML encodes to toml string
+func (v *VarnishStats) TOML() string {
+	var opts string
+	if v.Binary != "" {
+		opts += fmt.Sprintf("  ## The default location of the varnishstat binary can be overridden with:\n  binary = %s\n", strconv.Quote(v.Binary))
+	}
+	if len(v.Stats) > 0 {
+		opts += fmt.Sprintf("  ## Glob matching the stats fields to collect, exp: MAIN.*\n  stats = [%s]\n", quoteJoin(v.Stats))
+	}
+	if v.InstanceName != "" {
+		opts += fmt.Sprintf("  ## Optional name for the varnish instance to query.\n  instance_name = %s\n", strconv.Quote(v.InstanceName))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+%s`, v.PluginName(), opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (v *VarnishStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad binary for varnish input plugin")
+	}
+	v.Binary, _ = dataOK["binary"].(string)
+	stats, _ := dataOK["stats"].([]interface{})
+	for _, stat := range stats {
+		v.Stats = append(v.Stats, stat.(string))
+	}
+	v.InstanceName, _ = dataOK["instance_name"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (v *VarnishStats) Validate() error {
+	if v.Binary != "" && !path.IsAbs(v.Binary) {
+		return fmt.Errorf("binary %q must be an absolute path for varnish input plugin", v.Binary)
+	}
+	return nil
+}
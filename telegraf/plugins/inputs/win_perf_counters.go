@@ -0,0 +1,98 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// WinPerfCountersStats is based on telegraf WinPerfCounters plugin.
+type WinPerfCountersStats struct {
+	baseInput
+	Objects []WinPerfCounterObject `json:"object"`
+}
+
+// WinPerfCounterObject is a performance counter object of the win_perf_counters plugin.
+type WinPerfCounterObject struct {
+	ObjectName  string   `json:"ObjectName"`
+	Counters    []string `json:"Counters"`
+	Instances   []string `json:"Instances"`
+	Measurement string   `json:"Measurement"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (w *WinPerfCountersStats) PluginName() string {
+	return "win_perf_counters"
+}
+
+// TOML encodes to toml string
+func (w *WinPerfCountersStats) TOML() string {
+	objects := make([]string, len(w.Objects))
+	for k, o := range w.Objects {
+		objects[k] = fmt.Sprintf(`  [[inputs.%s.object]]
+    ObjectName = %s
+    Counters = [%s]
+    Instances = [%s]
+    Measurement = %s
+`, w.PluginName(), strconv.Quote(o.ObjectName), quoteJoin(o.Counters), quoteJoin(o.Instances), strconv.Quote(o.Measurement))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## Each object is a performance counter object to gather,
+  ## Instances = ["*"] gathers all the instances of the object.
+%s`, w.PluginName(), strings.Join(objects, ""))
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (w *WinPerfCountersStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad object for win_perf_counters input plugin")
+	}
+	var objects []interface{}
+	switch v := dataOK["object"].(type) {
+	case []interface{}:
+		objects = v
+	case []map[string]interface{}:
+		for _, o := range v {
+			objects = append(objects, o)
+		}
+	default:
+		return errors.New("object is not an array for win_perf_counters input plugin")
+	}
+	for _, obj := range objects {
+		o, ok := obj.(map[string]interface{})
+		if !ok {
+			return errors.New("bad object for win_perf_counters input plugin")
+		}
+		var po WinPerfCounterObject
+		po.ObjectName, _ = o["ObjectName"].(string)
+		po.Measurement, _ = o["Measurement"].(string)
+		counters, _ := o["Counters"].([]interface{})
+		for _, c := range counters {
+			po.Counters = append(po.Counters, c.(string))
+		}
+		instances, _ := o["Instances"].([]interface{})
+		for _, i := range instances {
+			po.Instances = append(po.Instances, i.(string))
+		}
+		w.Objects = append(w.Objects, po)
+	}
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (w *WinPerfCountersStats) Validate() error {
+	if len(w.Objects) == 0 {
+		return errors.New("at least one object is required for win_perf_counters input plugin")
+	}
+	for _, o := range w.Objects {
+		if o.ObjectName == "" {
+			return errors.New("object name is required for win_perf_counters input plugin")
+		}
+		if len(o.Counters) == 0 {
+			return fmt.Errorf("at least one counter is required for object %q of win_perf_counters input plugin", o.ObjectName)
+		}
+	}
+	return nil
+}
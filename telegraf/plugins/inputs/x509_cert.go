@@ -0,0 +1,57 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// X509CertStats is based on telegraf X509Cert plugin.
+type X509CertStats struct {
+	baseInput
+	Sources []string `json:"sources"`
+	Timeout string   `json:"timeout"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (x *X509CertStats) PluginName() string {
+	return "x509_cert"
+}
+
+// TOML encodes to toml string
+func (x *X509CertStats) TOML() string {
+	var opts string
+	if x.Timeout != "" {
+		opts = fmt.Sprintf("  ## Timeout for SSL connection\n  timeout = %s\n", strconv.Quote(x.Timeout))
+	}
+	return fmt.Sprintf(`[[inputs.%s]]
+  ## List certificate sources
+  ## exp: "/etc/ssl/certs/ssl-cert-snakeoil.pem", "tcp://example.org:443"
+  sources = [%s]
+%s`, x.PluginName(), quoteJoin(x.Sources), opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (x *X509CertStats) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad sources for x509_cert input plugin")
+	}
+	sources, ok := dataOK["sources"].([]interface{})
+	if !ok {
+		return errors.New("sources is not an array for x509_cert input plugin")
+	}
+	for _, source := range sources {
+		x.Sources = append(x.Sources, source.(string))
+	}
+	x.Timeout, _ = dataOK["timeout"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (x *X509CertStats) Validate() error {
+	if len(x.Sources) == 0 {
+		return errors.New("at least one source is required for x509_cert input plugin")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package outputs
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// InfluxDBOutput is based on telegraf influxdb output plugin,
+// which writes to the influxdb v1 line protocol endpoint.
+type InfluxDBOutput struct {
+	baseOutput
+	URLs            []string `json:"urls"`
+	Database        string   `json:"database"`
+	Username        string   `json:"username"`
+	Password        string   `json:"password"`
+	RetentionPolicy string   `json:"retention_policy"`
+}
+
+// PluginName is based on telegraf plugin name.
+func (i *InfluxDBOutput) PluginName() string {
+	return "influxdb"
+}
+
+// TOML encodes to toml string.
+func (i *InfluxDBOutput) TOML() string {
+	s := make([]string, len(i.URLs))
+	for k, v := range i.URLs {
+		s[k] = strconv.Quote(v)
+	}
+	var opts string
+	if i.RetentionPolicy != "" {
+		opts += fmt.Sprintf("\n  ## Name of existing retention policy to write to.\n  retention_policy = %s\n", strconv.Quote(i.RetentionPolicy))
+	}
+	if i.Username != "" || i.Password != "" {
+		opts += fmt.Sprintf("\n  ## HTTP Basic Auth\n  username = %s\n  password = %s\n", strconv.Quote(i.Username), strconv.Quote(i.Password))
+	}
+	return fmt.Sprintf(`[[outputs.%s]]
+  ## The full HTTP or UDP URL for your InfluxDB instance.
+  ##
+  ## Multiple URLs can be specified for a single cluster, only ONE of the
+  ## urls will be written to each interval.
+  ## urls exp: http://127.0.0.1:8086
+  urls = [%s]
+
+  ## The target database for metrics; will be created as needed.
+  database = %s
+%s`, i.PluginName(), strings.Join(s, ", "), strconv.Quote(i.Database), opts)
+}
+
+// UnmarshalTOML decodes the parsed data to the object
+func (i *InfluxDBOutput) UnmarshalTOML(data interface{}) error {
+	dataOK, ok := data.(map[string]interface{})
+	if !ok {
+		return errors.New("bad urls for influxdb output plugin")
+	}
+	urls, ok := dataOK["urls"].([]interface{})
+	if !ok {
+		return errors.New("urls is not an array for influxdb output plugin")
+	}
+	for _, url := range urls {
+		i.URLs = append(i.URLs, url.(string))
+	}
+	i.Database, _ = dataOK["database"].(string)
+	i.Username, _ = dataOK["username"].(string)
+	i.Password, _ = dataOK["password"].(string)
+	i.RetentionPolicy, _ = dataOK["retention_policy"].(string)
+	return nil
+}
+
+// Validate returns error if some configuration is invalid.
+func (i *InfluxDBOutput) Validate() error {
+	if len(i.URLs) == 0 {
+		return errors.New("at least one url is required for influxdb output plugin")
+	}
+	if i.Database == "" {
+		return errors.New("database is required for influxdb output plugin")
+	}
+	return nil
+}
@@ -34,6 +34,17 @@ func TestTOML(t *testing.T) {
 				&File{}: `[[outputs.file]]
   ## Files to write to, "stdout" is a specially handled file.
   files = []
+`,
+				&InfluxDBOutput{}: `[[outputs.influxdb]]
+  ## The full HTTP or UDP URL for your InfluxDB instance.
+  ##
+  ## Multiple URLs can be specified for a single cluster, only ONE of the
+  ## urls will be written to each interval.
+  ## urls exp: http://127.0.0.1:8086
+  urls = []
+
+  ## The target database for metrics; will be created as needed.
+  database = ""
 `,
 				&InfluxDBV2{}: `[[outputs.influxdb_v2]]	
   ## The URLs of the InfluxDB cluster nodes.
@@ -65,6 +76,47 @@ func TestTOML(t *testing.T) {
 				}: `[[outputs.file]]
   ## Files to write to, "stdout" is a specially handled file.
   files = ["stdout", "/tmp/out.txt"]
+`,
+				&InfluxDBOutput{
+					URLs:     []string{"http://127.0.0.1:8086"},
+					Database: "telegraf",
+				}: `[[outputs.influxdb]]
+  ## The full HTTP or UDP URL for your InfluxDB instance.
+  ##
+  ## Multiple URLs can be specified for a single cluster, only ONE of the
+  ## urls will be written to each interval.
+  ## urls exp: http://127.0.0.1:8086
+  urls = ["http://127.0.0.1:8086"]
+
+  ## The target database for metrics; will be created as needed.
+  database = "telegraf"
+`,
+				&InfluxDBOutput{
+					URLs: []string{
+						"http://192.168.1.10:8086",
+						"http://192.168.1.11:8086",
+					},
+					Database:        "telegraf",
+					Username:        "telegraf",
+					Password:        "metricsmetricsmetrics",
+					RetentionPolicy: "autogen",
+				}: `[[outputs.influxdb]]
+  ## The full HTTP or UDP URL for your InfluxDB instance.
+  ##
+  ## Multiple URLs can be specified for a single cluster, only ONE of the
+  ## urls will be written to each interval.
+  ## urls exp: http://127.0.0.1:8086
+  urls = ["http://192.168.1.10:8086", "http://192.168.1.11:8086"]
+
+  ## The target database for metrics; will be created as needed.
+  database = "telegraf"
+
+  ## Name of existing retention policy to write to.
+  retention_policy = "autogen"
+
+  ## HTTP Basic Auth
+  username = "telegraf"
+  password = "metricsmetricsmetrics"
 `,
 				&InfluxDBV2{
 					URLs: []string{
@@ -142,6 +194,30 @@ func TestDecodeTOML(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "influxdb empty",
+			want:    &InfluxDBOutput{},
+			wantErr: errors.New("bad urls for influxdb output plugin"),
+			output:  &InfluxDBOutput{},
+		},
+		{
+			name: "influxdb",
+			want: &InfluxDBOutput{
+				URLs:            []string{"http://127.0.0.1:8086"},
+				Database:        "telegraf",
+				Username:        "telegraf",
+				Password:        "metricsmetricsmetrics",
+				RetentionPolicy: "autogen",
+			},
+			output: &InfluxDBOutput{},
+			data: map[string]interface{}{
+				"urls":             []interface{}{"http://127.0.0.1:8086"},
+				"database":         "telegraf",
+				"username":         "telegraf",
+				"password":         "metricsmetricsmetrics",
+				"retention_policy": "autogen",
+			},
+		},
 		{
 			name:    "influxdb_v2 empty",
 			want:    &InfluxDBV2{},
@@ -250,3 +326,47 @@ func TestDecodeTOML(t *testing.T) {
 		}
 	}
 }
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		output  interface{ Validate() error }
+		wantErr error
+	}{
+		{
+			name: "influxdb",
+			output: &InfluxDBOutput{
+				URLs:     []string{"http://127.0.0.1:8086"},
+				Database: "telegraf",
+			},
+		},
+		{
+			name: "influxdb authenticated",
+			output: &InfluxDBOutput{
+				URLs:     []string{"http://127.0.0.1:8086"},
+				Database: "telegraf",
+				Username: "telegraf",
+				Password: "metricsmetricsmetrics",
+			},
+		},
+		{
+			name:    "influxdb without urls",
+			output:  &InfluxDBOutput{Database: "telegraf"},
+			wantErr: errors.New("at least one url is required for influxdb output plugin"),
+		},
+		{
+			name:    "influxdb without database",
+			output:  &InfluxDBOutput{URLs: []string{"http://127.0.0.1:8086"}},
+			wantErr: errors.New("database is required for influxdb output plugin"),
+		},
+	}
+	for _, c := range cases {
+		err := c.output.Validate()
+		if c.wantErr != nil && (err == nil || err.Error() != c.wantErr.Error()) {
+			t.Fatalf("%s failed want err %s, got %v", c.name, c.wantErr.Error(), err)
+		}
+		if c.wantErr == nil && err != nil {
+			t.Fatalf("%s failed want err nil, got %v", c.name, err)
+		}
+	}
+}
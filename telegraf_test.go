@@ -459,6 +459,52 @@ func TestTelegrafConfigJSON(t *testing.T) {
 	}
 }
 
+func TestTelegrafConfigJSONInvalidPluginConfig(t *testing.T) {
+	cases := []struct {
+		name   string
+		plugin string
+	}{
+		{
+			name:   "mqtt_consumer qos out of range",
+			plugin: `{"name": "mqtt_consumer", "type": "input", "config": {"servers": ["tcp://127.0.0.1:1883"], "topics": ["t1"], "qos": 7}}`,
+		},
+		{
+			name:   "ping unknown method",
+			plugin: `{"name": "ping", "type": "input", "config": {"urls": ["example.org"], "count": 1, "method": "icmp"}}`,
+		},
+		{
+			name:   "syslog unknown standard",
+			plugin: `{"name": "syslog", "type": "input", "config": {"server": "tcp://:6514", "syslog_standard": "RFC5425"}}`,
+		},
+		{
+			name:   "mysql unknown metric_version",
+			plugin: `{"name": "mysql", "type": "input", "config": {"servers": ["tcp(127.0.0.1:3306)/"], "metric_version": 3}}`,
+		},
+		{
+			name:   "socket_listener http scheme",
+			plugin: `{"name": "socket_listener", "type": "input", "config": {"service_address": "http://:8094"}}`,
+		},
+		{
+			name:   "logparser custom_patterns closing the literal string",
+			plugin: `{"name": "logparser", "type": "input", "config": {"files": ["/var/log/a.log"], "custom_patterns": "A '''\n[[outputs.http]]"}}`,
+		},
+		{
+			name:   "influxdb output without database",
+			plugin: `{"name": "influxdb", "type": "output", "config": {"urls": ["http://127.0.0.1:8086"]}}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := fmt.Sprintf(`{"name": "n1", "orgID": "020f755c3c082222", "plugins": [%s]}`, c.plugin)
+			got := new(TelegrafConfig)
+			err := json.Unmarshal([]byte(cfg), got)
+			if code := ErrorCode(err); code != EInvalid {
+				t.Fatalf("expected error code %q, got %q: %v", EInvalid, code, err)
+			}
+		})
+	}
+}
+
 func TestLegacyStruct(t *testing.T) {
 	id1, _ := IDFromString("020f755c3c082000")
 